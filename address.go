@@ -0,0 +1,170 @@
+package countries
+
+import (
+	"errors"
+	"strings"
+)
+
+// Address is a postal address.
+type Address struct {
+	Recipient    string
+	Organization string
+	Street       string
+	// Street2 is the second line of the street address, like the apartment or
+	// the floor.
+	Street2 string
+	// DependentLocality is a locality within the city, like a neighborhood or
+	// a village.
+	DependentLocality string
+	City              string
+	PostalCode        string
+	// SortingCode is the code that follows the city in some countries, like
+	// the CEDEX code in France.
+	SortingCode string
+	// Region is the code or the name of a subdivision of the country.
+	Region string
+}
+
+var (
+	// ErrMissingField is returned when a required address field is empty.
+	ErrMissingField = errors.New("countries: missing required field")
+	// ErrUnknownRegion is returned when the region of an address is not a
+	// subdivision of the country.
+	ErrUnknownRegion = errors.New("countries: unknown region")
+)
+
+// FieldError is an error of an address field.
+type FieldError struct {
+	// Field is the name of the Address field, like "PostalCode".
+	Field string
+	Err   error
+}
+
+// Error returns the field name followed by the error message.
+func (e FieldError) Error() string {
+	return e.Field + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e FieldError) Unwrap() error {
+	return e.Err
+}
+
+// FormatStructuredAddress returns the formatted address based on
+// country.AddressFormat template, see AddressTemplate. Empty fields are removed
+// with their separators, as are the lines left empty. Unless the template
+// contains their own placeholders, the organization and the second street line
+// follow the recipient and the street on their own lines, as does the
+// dependent locality after the street; the sorting code follows the city.
+func (c *Country) FormatStructuredAddress(address Address) string {
+	return c.addressTemplate().Format(c, address)
+}
+
+// FormatAddressFor returns the formatted address of a shipment sent from the
+// country from, as recommended by the UPU: the country line is omitted for
+// domestic mail, otherwise it is the name of the country translated in locale
+// or, if locale is empty, in the first official language of from that has a
+// translation, falling back to country.ISOShortName. If locale is not empty
+// the region name is translated too, when a translation exists. A nil from
+// country is treated as a foreign one.
+func (c *Country) FormatAddressFor(address Address, from *Country, locale string) string {
+	return c.addressTemplate().format(c, address, c.addressCountry(from, locale), locale)
+}
+
+// FormatPostalAddress returns the formatted address of a shipment sent from the
+// country from like FormatAddressFor, with the transforms required by the
+// postal service of the country applied on top of country.AddressFormat, like
+// the uppercase city of French and British addresses.
+func (c *Country) FormatPostalAddress(address Address, from *Country, locale string) string {
+	return c.postalAddressTemplate().format(c, address, c.addressCountry(from, locale), locale)
+}
+
+// addressCountry returns the value of the country placeholder of an address
+// sent from the country from, see FormatAddressFor.
+func (c *Country) addressCountry(from *Country, locale string) addressValue {
+	country := addressValue{long: c.ISOShortName, short: c.Alpha2}
+	if from != nil && from.Alpha2 == c.Alpha2 {
+		country = addressValue{}
+	} else if name := c.Translations[locale]; name != "" {
+		country.long = name
+	} else if locale == "" && from != nil {
+		for _, language := range from.LanguagesOfficial {
+			if name := c.Translations[language]; name != "" {
+				country.long = name
+				break
+			}
+		}
+	}
+	return country
+}
+
+// ValidateAddress checks the address against the country rules and returns
+// the errors found, one per invalid field, or nil if the address is valid.
+// The required fields depend on the country, see RequiredAddressFields. The
+// postal code must match the country format and the region must be a
+// subdivision of the country consistent with the postal code, see
+// CheckPostalCodeRegion.
+func (c *Country) ValidateAddress(address Address) []FieldError {
+	var errs []FieldError
+	present := func(field, value string) bool {
+		if strings.TrimSpace(value) != "" {
+			return true
+		}
+		if c.addressFieldRequired(field) {
+			errs = append(errs, FieldError{Field: field, Err: ErrMissingField})
+		}
+		return false
+	}
+	present("Recipient", address.Recipient)
+	present("Street", address.Street)
+	present("City", address.City)
+	if c.HasPostalCode() && present("PostalCode", address.PostalCode) {
+		if err := c.ValidatePostalCode(address.PostalCode); err != nil {
+			errs = append(errs, FieldError{Field: "PostalCode", Err: err})
+		}
+	}
+	if len(c.Subdivisions) > 0 && present("Region", address.Region) {
+		if c.findSubdivision(address.Region).Code == "" {
+			errs = append(errs, FieldError{Field: "Region", Err: ErrUnknownRegion})
+		} else if err := c.CheckPostalCodeRegion(address.PostalCode, address.Region); err != nil {
+			errs = append(errs, FieldError{Field: "Region", Err: err})
+		}
+	}
+	return errs
+}
+
+// RequiredAddressFields returns the names of the Address fields required by
+// the country postal service, like "Region". Unless the country data says
+// otherwise, Recipient, Street and City are required, as is PostalCode if the
+// country has postal codes.
+func (c *Country) RequiredAddressFields() []string {
+	if fields, found := addressRequiredFields[c.Alpha2]; found {
+		return append([]string(nil), fields...)
+	}
+	fields := []string{"Recipient", "Street", "City"}
+	if c.HasPostalCode() {
+		fields = append(fields, "PostalCode")
+	}
+	return fields
+}
+
+// addressFieldRequired reports whether the Address field is required by the
+// country.
+func (c *Country) addressFieldRequired(field string) bool {
+	for _, f := range c.RequiredAddressFields() {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+func joinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, v := range values {
+		if v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, sep)
+}
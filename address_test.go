@@ -0,0 +1,125 @@
+package countries_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatStructuredAddress(t *testing.T) {
+	it := countries.Get("IT")
+	address := it.FormatStructuredAddress(countries.Address{
+		Recipient:    "Enrico Pilotto",
+		Organization: "ACME S.r.l.",
+		Street:       "via Garibaldi 15",
+		Street2:      "Scala B",
+		PostalCode:   "97011",
+		City:         "Acate",
+		Region:       "Ragusa",
+	})
+	assert.Equal(t, "Enrico Pilotto\nACME S.r.l.\nvia Garibaldi 15\nScala B\n97011 Acate RG\nItaly", address)
+
+	address = it.FormatStructuredAddress(countries.Address{Recipient: "Enrico Pilotto", Street: "via Garibaldi 15", PostalCode: "97011", City: "Acate", Region: "RG"})
+	assert.Equal(t, it.FormatAddress("Enrico Pilotto", "via Garibaldi 15", "97011", "Acate", "RG"), address)
+}
+
+func TestFormatAddressFor(t *testing.T) {
+	es := countries.Get("ES")
+	address := countries.Address{Recipient: "Enrico Pilotto", Street: "via Garibaldi 15", PostalCode: "41001", City: "Sevilla", Region: "AN"}
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n41001 Sevilla\nAndalucía", es.FormatAddressFor(address, es, ""))
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n41001 Sevilla\nAndalucía\nSpagna", es.FormatAddressFor(address, countries.Get("IT"), ""))
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n41001 Sevilla\nAndalusia\nSpagna", es.FormatAddressFor(address, countries.Get("IT"), "it"))
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n41001 Sevilla\nAndalusia\nSpain", es.FormatAddressFor(address, countries.Get("IT"), "en"))
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n41001 Sevilla\nAndalucía\nSpain", es.FormatAddressFor(address, nil, ""))
+	// Unknown locales fall back to the English names.
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n41001 Sevilla\nAndalucía\nSpain", es.FormatAddressFor(address, countries.Get("IT"), "xx"))
+
+	us := countries.Get("US")
+	address = countries.Address{Recipient: "John Smith", Street: "1084 Nuzum Court", PostalCode: "14214", City: "Buffalo", Region: "NY"}
+	assert.Equal(t, "John Smith\n1084 Nuzum Court\nBuffalo NY 14214", us.FormatAddressFor(address, us, "en"))
+	assert.Equal(t, "John Smith\n1084 Nuzum Court\nBuffalo NY 14214\n米国", us.FormatAddressFor(address, countries.Get("JP"), ""))
+}
+
+func TestValidateAddress(t *testing.T) {
+	us := countries.Get("US")
+	address := countries.Address{Recipient: "John Smith", Street: "1084 Nuzum Court", PostalCode: "14214", City: "Buffalo", Region: "NY"}
+	assert.Nil(t, us.ValidateAddress(address))
+	address.Region = "New York"
+	assert.Nil(t, us.ValidateAddress(address))
+
+	errs := us.ValidateAddress(countries.Address{})
+	fields := make([]string, len(errs))
+	for i, err := range errs {
+		fields[i] = err.Field
+		assert.True(t, errors.Is(err, countries.ErrMissingField), err.Field)
+	}
+	assert.Equal(t, []string{"Recipient", "Street", "City", "PostalCode", "Region"}, fields)
+
+	address = countries.Address{Recipient: "John Smith", Street: "1084 Nuzum Court", PostalCode: "x14214", City: "Buffalo", Region: "XX"}
+	errs = us.ValidateAddress(address)
+	if assert.Equal(t, 2, len(errs)) {
+		assert.Equal(t, "PostalCode", errs[0].Field)
+		assert.True(t, errors.Is(errs[0], countries.ErrInvalidPostalCode))
+		assert.Equal(t, "Region", errs[1].Field)
+		assert.True(t, errors.Is(errs[1], countries.ErrUnknownRegion))
+	}
+
+	address = countries.Address{Recipient: "John Smith", Street: "1084 Nuzum Court", PostalCode: "14214", City: "Buffalo", Region: "CA"}
+	errs = us.ValidateAddress(address)
+	if assert.Equal(t, 1, len(errs)) {
+		assert.True(t, errors.Is(errs[0], countries.ErrPostalCodeMismatch))
+	}
+
+	// The region is optional unless the country requires it.
+	de := countries.Get("DE")
+	assert.Nil(t, de.ValidateAddress(countries.Address{Recipient: "Max Mustermann", Street: "Musterstraße 1", PostalCode: "10115", City: "Berlin"}))
+	errs = de.ValidateAddress(countries.Address{Recipient: "Max Mustermann", Street: "Musterstraße 1", PostalCode: "10115", City: "Berlin", Region: "XX"})
+	if assert.Equal(t, 1, len(errs)) {
+		assert.True(t, errors.Is(errs[0], countries.ErrUnknownRegion))
+	}
+
+	gb := countries.Get("GB")
+	assert.Nil(t, gb.ValidateAddress(countries.Address{Recipient: "Jane Doe", Street: "10 Downing Street", PostalCode: "SW1A 2AA", City: "London"}))
+
+	// Countries without postal codes do not require them.
+	jm := countries.Get("JM")
+	assert.Equal(t, 0, len(jm.ValidateAddress(countries.Address{Recipient: "Bob", Street: "1 Hope Road", City: "Kingston", Region: "01"})))
+}
+
+func TestRequiredAddressFields(t *testing.T) {
+	assert.Equal(t, []string{"Recipient", "Street", "City", "PostalCode", "Region"}, countries.Get("US").RequiredAddressFields())
+	assert.Equal(t, []string{"Recipient", "Street", "City", "PostalCode"}, countries.Get("GB").RequiredAddressFields())
+	assert.Equal(t, []string{"Recipient", "Street", "City"}, countries.Get("JM").RequiredAddressFields())
+	assert.Equal(t, []string{"Recipient", "Street"}, countries.Get("HK").RequiredAddressFields())
+
+	// Required fields can be filled in.
+	for _, c := range countries.All {
+		for _, field := range c.RequiredAddressFields() {
+			switch field {
+			case "PostalCode":
+				assert.True(t, c.HasPostalCode(), c.Alpha2)
+			case "Region":
+				assert.NotEmpty(t, c.Subdivisions, c.Alpha2)
+			}
+		}
+	}
+}
+
+func ExampleCountry_ValidateAddress() {
+	c := countries.Get("US")
+	errs := c.ValidateAddress(countries.Address{
+		Recipient:  "John Smith",
+		Street:     "1084 Nuzum Court",
+		PostalCode: "14214",
+		City:       "Buffalo",
+		Region:     "California",
+	})
+	for _, err := range errs {
+		fmt.Println(err)
+	}
+	// Output:
+	// Region: countries: postal code does not match region: "14214" is in US-NY, not in US-CA
+}
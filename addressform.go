@@ -0,0 +1,165 @@
+package countries
+
+// AddressFormSchema describes the address form of a country, so that clients
+// can render it and validate the fields before submitting the address.
+type AddressFormSchema struct {
+	Country string             `json:"country"`
+	Fields  []AddressFormField `json:"fields"`
+}
+
+// AddressFormField is a field of an address form.
+type AddressFormField struct {
+	// Name is the name of the Address field, like "PostalCode", as in
+	// FieldError.
+	Name  string `json:"name"`
+	Label string `json:"label"`
+	// Line is the line of the address where the field is, starting from 0.
+	// Fields on the same line can be rendered side by side.
+	Line     int  `json:"line"`
+	Required bool `json:"required"`
+	// Pattern is the regular expression the value must match, if any. It is
+	// anchored, so that it can be used as the pattern of an HTML input too.
+	Pattern string `json:"pattern,omitempty"`
+	Example string `json:"example,omitempty"`
+	Hint    string `json:"hint,omitempty"`
+	// Options are the allowed values of the field, if any.
+	Options []AddressFormOption `json:"options,omitempty"`
+}
+
+// AddressFormOption is an allowed value of an address form field.
+type AddressFormOption struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+// defaultAddressTemplate is the address template used by the form of
+// countries without an address format.
+var defaultAddressTemplate, _ = ParseAddressTemplate("{{recipient}}\n{{street}}\n{{city}}\n{{region}}\n{{postalcode}}")
+
+// addressFormFields maps the placeholders of an address template to the
+// Address fields and their English labels; the translations are in
+// addressFieldLabels.
+var addressFormFields = map[string]struct{ name, label string }{
+	"recipient":          {"Recipient", "Full name"},
+	"organization":       {"Organization", "Organization"},
+	"street":             {"Street", "Street address"},
+	"street2":            {"Street2", "Apartment, suite, etc."},
+	"dependent_locality": {"DependentLocality", "Neighborhood"},
+	"city":               {"City", "City"},
+	"sorting_code":       {"SortingCode", "Sorting code"},
+	"postalcode":         {"PostalCode", "Postal code"},
+	"region":             {"Region", "Region"},
+}
+
+// AddressFormSchema returns the schema of the country address form: the fields
+// in the order of country.AddressFormat, with the organization and the second
+// street line as optional fields following the recipient and the street. The
+// required fields are the ones checked by ValidateAddress; the postal code
+// field has the country pattern and the region field has the subdivisions
+// that can appear in an address as options, sorted by name. Field and option
+// labels are translated in locale when possible, falling back to English.
+func (c *Country) AddressFormSchema(locale string) AddressFormSchema {
+	t := c.addressTemplate()
+	if c.AddressFormat == "" {
+		t = defaultAddressTemplate
+	}
+	schema := AddressFormSchema{Country: c.Alpha2, Fields: make([]AddressFormField, 0)}
+	seen := make(map[string]bool)
+	add := func(placeholder string, line int) {
+		f := addressFormFields[placeholder]
+		if f.name == "" || seen[f.name] {
+			return
+		}
+		seen[f.name] = true
+		field := AddressFormField{Name: f.name, Label: addressFieldLabel(f.name, f.label, locale), Line: line, Required: c.addressFieldRequired(f.name)}
+		switch f.name {
+		case "PostalCode":
+			if !c.HasPostalCode() {
+				return
+			}
+			field.Pattern = "^(?:" + c.PostalCodeFormat + ")$"
+			field.Example = c.PostalCodeExample()
+			field.Hint = c.PostalCodeHint()
+		case "Region":
+			regions := c.addressRegions(locale)
+			if len(regions) == 0 {
+				return
+			}
+			field.Label = addressRegionLabel(regions, locale)
+			for _, s := range regions {
+				field.Options = append(field.Options, AddressFormOption{Value: s.Code, Label: s.localizedName(locale)})
+			}
+		}
+		schema.Fields = append(schema.Fields, field)
+	}
+	line := 0
+	for _, tokens := range t.lines {
+		placeholders := 0
+		for _, token := range tokens {
+			if token.field == "" || token.field == "country" {
+				continue
+			}
+			add(token.field, line)
+			placeholders++
+			switch {
+			case token.field == "recipient" && !t.Has("organization"):
+				line++
+				add("organization", line)
+			case token.field == "street" && !t.Has("street2"):
+				line++
+				add("street2", line)
+			}
+		}
+		if placeholders > 0 {
+			line++
+		}
+	}
+	return schema
+}
+
+// addressRegions returns the subdivisions without children, the ones that can
+// appear in an address, sorted by their name translated in locale.
+func (c *Country) addressRegions(locale string) []Subdivision {
+	parents := make(map[string]bool)
+	for _, s := range c.Subdivisions {
+		if s.ParentCode != "" {
+			parents[s.ParentCode] = true
+		}
+	}
+	var regions []Subdivision
+	for _, s := range c.SubdivisionList(SubdivisionListOptions{Order: SubdivisionOrderName, Locale: locale}) {
+		if !parents[s.Code] {
+			regions = append(regions, s)
+		}
+	}
+	return regions
+}
+
+// addressRegionLabel returns the label of the region field in locale: the
+// label of the most common subdivision type of the regions, like "State" in the
+// United States, where the District of Columbia and the outlying areas have
+// other types. Ties are broken by type.
+func addressRegionLabel(regions []Subdivision, locale string) string {
+	counts := make(map[SubdivisionType]int)
+	var dominant SubdivisionType
+	for _, s := range regions {
+		t := s.Kind()
+		counts[t]++
+		if n := counts[t]; n > counts[dominant] || n == counts[dominant] && t < dominant {
+			dominant = t
+		}
+	}
+	if dominant == "" {
+		return addressFieldLabel("Region", addressFormFields["region"].label, locale)
+	}
+	return dominant.Label(locale)
+}
+
+// addressFieldLabel returns the label of the Address field translated in
+// locale, or the English label if there is no translation.
+func addressFieldLabel(field, english, locale string) string {
+	if label, found := localizedLabel(addressFieldLabels[field], locale); found {
+		return label
+	}
+	return english
+}
@@ -0,0 +1,111 @@
+package countries_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func fieldNames(schema countries.AddressFormSchema) []string {
+	names := make([]string, len(schema.Fields))
+	for i, f := range schema.Fields {
+		names[i] = f.Name
+	}
+	return names
+}
+
+func TestAddressFormSchema(t *testing.T) {
+	us := countries.Get("US").AddressFormSchema("en")
+	assert.Equal(t, "US", us.Country)
+	assert.Equal(t, []string{"Recipient", "Organization", "Street", "Street2", "City", "Region", "PostalCode"}, fieldNames(us))
+	region, postalCode := us.Fields[5], us.Fields[6]
+	assert.True(t, region.Required)
+	assert.Equal(t, 4, region.Line)
+	assert.Equal(t, 4, postalCode.Line)
+	assert.Equal(t, countries.AddressFormOption{Value: "AL", Label: "Alabama"}, region.Options[0])
+	assert.Equal(t, "State", region.Label)
+	assert.True(t, postalCode.Required)
+	assert.Equal(t, "^(?:"+countries.Get("US").PostalCodeFormat+")$", postalCode.Pattern)
+	pattern := regexp.MustCompile(postalCode.Pattern)
+	assert.True(t, pattern.MatchString("14214-1234"))
+	assert.False(t, pattern.MatchString("x14214"))
+	assert.False(t, pattern.MatchString("142145"))
+	assert.Equal(t, "12345", postalCode.Example)
+	assert.False(t, us.Fields[1].Required)
+
+	gb := countries.Get("GB").AddressFormSchema("")
+	assert.Contains(t, fieldNames(gb), "Region")
+	for _, field := range gb.Fields {
+		if field.Name == "Region" {
+			assert.False(t, field.Required)
+		}
+	}
+
+	// The region label is the one of the most common subdivision type.
+	for _, test := range []struct{ alpha2, locale, label string }{
+		{"US", "es", "Estado"},
+		{"CA", "", "Province"},
+		{"CA", "fr_CA", "Province"},
+		{"BR", "", "State"},
+	} {
+		for _, field := range countries.Get(test.alpha2).AddressFormSchema(test.locale).Fields {
+			if field.Name == "Region" {
+				assert.Equal(t, test.label, field.Label, test.alpha2)
+			}
+		}
+	}
+
+	// Only the subdivisions without children are options.
+	it := countries.Get("IT").AddressFormSchema("")
+	assert.Equal(t, []string{"Recipient", "Organization", "Street", "Street2", "PostalCode", "City", "Region"}, fieldNames(it))
+	assert.Equal(t, "Full name", it.Fields[0].Label)
+	assert.Equal(t, "Province", it.Fields[6].Label)
+	it = countries.Get("IT").AddressFormSchema("it_IT")
+	assert.Equal(t, "Nome e cognome", it.Fields[0].Label)
+	assert.Equal(t, "CAP", it.Fields[4].Label)
+	assert.Equal(t, "Provincia", it.Fields[6].Label)
+	for _, o := range it.Fields[6].Options {
+		assert.NotEqual(t, "82", o.Value)
+	}
+
+	jp := countries.Get("JP").AddressFormSchema("ja")
+	assert.Equal(t, "PostalCode", jp.Fields[0].Name)
+	assert.Equal(t, "郵便番号", jp.Fields[0].Label)
+	assert.Equal(t, "都道府県", jp.Fields[1].Label)
+	assert.Contains(t, jp.Fields[1].Options, countries.AddressFormOption{Value: "23", Label: "愛知県"})
+
+	// The region is optional when the address format does not contain it.
+	de := countries.Get("DE").AddressFormSchema("")
+	assert.Equal(t, []string{"Recipient", "Organization", "Street", "Street2", "PostalCode", "City"}, fieldNames(de))
+
+	// Countries without postal codes have no postal code field.
+	jm := countries.Get("JM").AddressFormSchema("")
+	assert.NotContains(t, fieldNames(jm), "PostalCode")
+
+	for _, c := range countries.All {
+		schema := c.AddressFormSchema("en")
+		assert.NotEmpty(t, schema.Fields, c.Alpha2)
+		for _, f := range schema.Fields {
+			if f.Name == "Region" {
+				assert.NotEmpty(t, f.Options, c.Alpha2)
+			}
+		}
+	}
+}
+
+func TestAddressFormSchemaJSON(t *testing.T) {
+	b, err := json.Marshal(countries.Get("DE").AddressFormSchema(""))
+	assert.Nil(t, err)
+	var schema map[string]interface{}
+	assert.Nil(t, json.Unmarshal(b, &schema))
+	assert.Equal(t, "DE", schema["country"])
+	fields := schema["fields"].([]interface{})
+	postalCode := fields[4].(map[string]interface{})
+	assert.Equal(t, "PostalCode", postalCode["name"])
+	assert.Equal(t, true, postalCode["required"])
+	assert.Equal(t, `^(?:\d{5})$`, postalCode["pattern"])
+	assert.Nil(t, postalCode["options"])
+}
@@ -0,0 +1,483 @@
+package countries
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// ParsedAddress is an address parsed from free text.
+type ParsedAddress struct {
+	Address
+	// Confidence maps the names of the parsed Address fields, like
+	// "PostalCode", to a score from 0, a guess, to 1, a certain value.
+	Confidence map[string]float64
+}
+
+// addressLine is the parsed value of a line of an address template.
+type addressLine struct {
+	values     map[string]string
+	confidence map[string]float64
+	score      float64
+}
+
+// Scores of the alignment of the address lines to the template lines.
+// Skipping a template line without required fields costs less, so that a line
+// that matches both a required and an optional field, like a city that is
+// also the name of a region, is assigned to the required one.
+const (
+	addressSkippedLineScore         = -1
+	addressSkippedOptionalLineScore = -0.5
+	addressUnmatchedLineScore       = -2
+	addressDroppedLineScore         = -3
+	addressExtraLineScore           = -0.1
+)
+
+// addressGuessConfidence is the confidence of a guessed field: fields add
+// their confidence above it to the score of a line, so that lines are not
+// split into unlikely fields.
+const addressGuessConfidence = 0.5
+
+// addressExtraLineConfidence is the confidence of the fields parsed from the
+// extra lines that follow the recipient and the street.
+const addressExtraLineConfidence = 0.4
+
+// ParseAddress parses a multi-line address of the country, like the output of
+// FormatAddress, into its fields. It is a best-effort parser: the lines are
+// aligned to the lines of country.AddressFormat, postal codes are recognized
+// by country.PostalCodeFormat and regions by the names and codes of the
+// subdivisions; extra lines after the recipient and the street are the
+// organization and the second street line. A single line address is split on
+// commas. Postal codes are normalized, see NormalizePostalCode, and the region
+// is returned as the subdivision code if it is known.
+func (c *Country) ParseAddress(s string) ParsedAddress {
+	parsed := ParsedAddress{Confidence: make(map[string]float64)}
+	lines := addressInputLines(s)
+	if n := len(lines); n > 0 && c.isCountryLine(lines[n-1]) {
+		lines = lines[:n-1]
+	}
+	if len(lines) == 0 {
+		return parsed
+	}
+	t := c.addressTemplate()
+	if c.AddressFormat == "" {
+		t = defaultAddressTemplate
+	}
+	var templateLines [][]addressToken
+	var skipped []float64
+	for _, tokens := range t.lines {
+		if fields := addressLineFields(tokens); len(fields) > 0 && !(len(fields) == 1 && fields[0] == "country") {
+			templateLines = append(templateLines, tokens)
+			skipped = append(skipped, c.addressSkippedLineScore(fields))
+		}
+	}
+
+	// best[i][j] is the best score of the first i template lines aligned to
+	// the first j address lines; choice[i][j] is the number of address lines
+	// taken by the template line i-1, or -1 if the address line j-1 has been
+	// dropped.
+	m, n := len(templateLines), len(lines)
+	best := make([][]float64, m+1)
+	choice := make([][]int, m+1)
+	parts := make([][]addressLine, m+1)
+	for i := range best {
+		best[i] = make([]float64, n+1)
+		choice[i] = make([]int, n+1)
+		parts[i] = make([]addressLine, n+1)
+		for j := range best[i] {
+			best[i][j] = math.Inf(-1)
+		}
+	}
+	best[0][0] = 0
+	matches := make(map[[2]int]addressLine)
+	match := func(i, j int) addressLine {
+		key := [2]int{i, j}
+		if l, found := matches[key]; found {
+			return l
+		}
+		l := c.matchAddressLine(templateLines[i], lines[j])
+		matches[key] = l
+		return l
+	}
+	for i := 0; i <= m; i++ {
+		for j := 0; j <= n; j++ {
+			if i == 0 && j == 0 {
+				continue
+			}
+			if j > 0 && best[i][j-1]+addressDroppedLineScore > best[i][j] {
+				best[i][j] = best[i][j-1] + addressDroppedLineScore
+				choice[i][j] = -1
+			}
+			if i == 0 {
+				continue
+			}
+			if best[i-1][j]+skipped[i-1] > best[i][j] {
+				best[i][j] = best[i-1][j] + skipped[i-1]
+				choice[i][j] = 0
+			}
+			if j == 0 {
+				continue
+			}
+			l := match(i-1, j-1)
+			if best[i-1][j-1]+l.score > best[i][j] {
+				best[i][j] = best[i-1][j-1] + l.score
+				choice[i][j] = 1
+				parts[i][j] = l
+			}
+			field := addressMultiLineField(templateLines[i-1])
+			if field == "" {
+				continue
+			}
+			for k := 2; k <= j; k++ {
+				first := match(i-1, j-k)
+				if _, found := first.values[field]; !found {
+					continue
+				}
+				score := best[i-1][j-k] + first.score + float64(k-1)*(addressExtraLineConfidence-addressGuessConfidence+addressExtraLineScore)
+				if score > best[i][j] {
+					best[i][j] = score
+					choice[i][j] = k
+					parts[i][j] = first
+				}
+			}
+		}
+	}
+
+	for i, j := m, n; i > 0 || j > 0; {
+		k := choice[i][j]
+		switch {
+		case k < 0:
+			j--
+			continue
+		case k == 0:
+			i--
+			continue
+		}
+		for field, value := range parts[i][j].values {
+			parsed.set(field, value, parts[i][j].confidence[field])
+		}
+		if k > 1 {
+			parsed.setExtraLines(addressMultiLineField(templateLines[i-1]), lines[j-k+1:j])
+		}
+		i, j = i-1, j-k
+	}
+	c.resolveParsedRegion(&parsed)
+	return parsed
+}
+
+// addressSkippedLineScore returns the score of skipping a template line with
+// the fields: lower if the country requires one of them.
+func (c *Country) addressSkippedLineScore(fields []string) float64 {
+	for _, field := range fields {
+		if c.addressFieldRequired(addressFormFields[field].name) {
+			return addressSkippedLineScore
+		}
+	}
+	return addressSkippedOptionalLineScore
+}
+
+// addressInputLines returns the trimmed non empty lines of s. A single line is
+// split on commas.
+func addressInputLines(s string) []string {
+	split := strings.Split(s, "\n")
+	if len(split) == 1 {
+		split = strings.Split(s, ",")
+	}
+	var lines []string
+	for _, line := range split {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// isCountryLine reports whether the line is the name or the code of the
+// country.
+func (c *Country) isCountryLine(line string) bool {
+	if strings.EqualFold(line, c.Alpha2) || strings.EqualFold(line, c.Alpha3) {
+		return true
+	}
+	name := NormalizeName(line)
+	if name == NormalizeName(c.ISOShortName) {
+		return true
+	}
+	for _, translation := range c.Translations {
+		if name == NormalizeName(translation) {
+			return true
+		}
+	}
+	return false
+}
+
+// addressLineFields returns the fields of the placeholders of a template line,
+// in order and without duplicates.
+func addressLineFields(tokens []addressToken) []string {
+	var fields []string
+	for _, token := range tokens {
+		if token.field != "" && !containsString(fields, token.field) {
+			fields = append(fields, token.field)
+		}
+	}
+	return fields
+}
+
+// addressMultiLineField returns the field of a template line ending with the
+// recipient or the street, whose value can span more lines.
+func addressMultiLineField(tokens []addressToken) string {
+	if len(tokens) == 0 {
+		return ""
+	}
+	if field := tokens[len(tokens)-1].field; field == "recipient" || field == "street" {
+		return field
+	}
+	return ""
+}
+
+// matchAddressLine matches the line against the template line, trying every
+// subset of its fields, since empty fields are removed from formatted
+// addresses, and returns the best match. The whole line is assigned to the
+// first field if nothing matches.
+func (c *Country) matchAddressLine(tokens []addressToken, line string) addressLine {
+	fields := addressLineFields(tokens)
+	result := addressLine{score: math.Inf(-1)}
+	// Countries without postal codes have no postal code in their addresses.
+	skip := 0
+	for k, field := range fields {
+		if field == "postalcode" && !c.HasPostalCode() {
+			skip |= 1 << k
+		}
+	}
+	for mask := 1; mask < 1<<len(fields); mask++ {
+		if mask&skip != 0 {
+			continue
+		}
+		for _, strict := range []bool{true, false} {
+			re, present := c.addressLineRegexp(tokens, fields, mask, strict)
+			if re == nil {
+				continue
+			}
+			m := re.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			l := addressLine{values: make(map[string]string), confidence: make(map[string]float64)}
+			for k, field := range present {
+				value := strings.TrimSpace(m[re.SubexpIndex("f"+strconv.Itoa(k))])
+				if field == "postalcode" && !c.MatchPostalCode(value) {
+					if normalized, err := c.NormalizePostalCode(value); err == nil {
+						value = normalized
+					}
+				}
+				l.values[field] = value
+				l.confidence[field] = c.addressFieldConfidence(field, value)
+				l.score += l.confidence[field] - addressGuessConfidence
+			}
+			if l.score > result.score {
+				result = l
+			}
+		}
+	}
+	if result.values == nil {
+		result = addressLine{
+			values:     map[string]string{fields[0]: line},
+			confidence: map[string]float64{fields[0]: 0.2},
+			score:      addressUnmatchedLineScore,
+		}
+	}
+	return result
+}
+
+var addressLineRegexps sync.Map
+
+// addressLineRegexp returns the regexp matching the template line with the
+// fields in mask only, as rendered by AddressTemplate.Format, and the fields
+// of its groups: group fK is the k-th field. If strict, postal codes must
+// match the country format and regions a subdivision name or code.
+func (c *Country) addressLineRegexp(tokens []addressToken, fields []string, mask int, strict bool) (*regexp.Regexp, []string) {
+	values := make(map[string]addressValue)
+	for k, field := range fields {
+		if mask&(1<<k) != 0 {
+			sentinel := "\x00" + strconv.Itoa(k) + "\x00"
+			values[field] = addressValue{long: sentinel, short: sentinel}
+		}
+	}
+	rendered := formatAddressLine(tokens, values)
+	if rendered == "" {
+		return nil, nil
+	}
+	var b strings.Builder
+	var present []string
+	b.WriteString(`(?i)^`)
+	for i, part := range strings.Split(rendered, "\x00") {
+		if i%2 == 0 {
+			b.WriteString(strings.ReplaceAll(regexp.QuoteMeta(part), " ", `\s+`))
+			continue
+		}
+		k, _ := strconv.Atoi(part)
+		b.WriteString(`(?P<f` + strconv.Itoa(len(present)) + `>`)
+		b.WriteString(c.addressFieldPattern(fields[k], strict))
+		b.WriteString(`)`)
+		present = append(present, fields[k])
+	}
+	b.WriteString(`$`)
+	pattern := b.String()
+	if re, found := addressLineRegexps.Load(pattern); found {
+		return re.(*regexp.Regexp), present
+	}
+	re, _ := addressLineRegexps.LoadOrStore(pattern, regexp.MustCompile(pattern))
+	return re.(*regexp.Regexp), present
+}
+
+// addressCityPatterns maps country alpha2 codes to the pattern of the city in
+// countries whose addresses do not separate it from the following field, like
+// Japan where city names end with the municipality suffix.
+var addressCityPatterns = map[string]string{
+	"JP": `.+?[市区町村]`,
+}
+
+// addressFieldPattern returns the pattern of the values of the field.
+func (c *Country) addressFieldPattern(field string, strict bool) string {
+	switch {
+	case strict && field == "postalcode" && c.PostalCodeFormat != "":
+		return `(?:` + c.PostalCodeFormat + `)`
+	case strict && field == "region" && len(c.Subdivisions) > 0:
+		return c.regionPattern()
+	case strict && field == "city" && addressCityPatterns[c.Alpha2] != "":
+		return addressCityPatterns[c.Alpha2]
+	}
+	return `.+?`
+}
+
+var regionPatterns sync.Map
+
+// regionPattern returns the pattern matching the names and the codes of the
+// country's subdivisions, longest first. Names include the translations in the
+// official languages of the country.
+func (c *Country) regionPattern() string {
+	if p, found := regionPatterns.Load(c.Alpha2); found {
+		return p.(string)
+	}
+	var names []string
+	for _, s := range c.Subdivisions {
+		names = append(names, regexp.QuoteMeta(s.Code), regexp.QuoteMeta(s.Name))
+		for _, name := range s.UnofficialNames {
+			names = append(names, regexp.QuoteMeta(name))
+		}
+		for _, language := range c.LanguagesOfficial {
+			if name := s.Translations[language]; name != "" {
+				names = append(names, regexp.QuoteMeta(name))
+			}
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	p, _ := regionPatterns.LoadOrStore(c.Alpha2, `(?:`+strings.Join(names, "|")+`)`)
+	return p.(string)
+}
+
+// addressFieldConfidence returns the confidence of the value of a field found
+// at its place in the address.
+func (c *Country) addressFieldConfidence(field, value string) float64 {
+	digits := strings.IndexFunc(value, unicode.IsDigit) >= 0
+	switch field {
+	case "postalcode":
+		if c.PostalCodeFormat == "" {
+			return 0.5
+		}
+		if c.MatchPostalCode(value) {
+			return 1
+		}
+		return 0.2
+	case "region":
+		if c.findSubdivision(value).Code != "" {
+			return 0.8
+		}
+		return 0.3
+	case "city":
+		return 0.7
+	case "recipient":
+		if digits {
+			return 0.5
+		}
+		return 0.7
+	case "street":
+		if digits {
+			return 0.7
+		}
+		return 0.5
+	}
+	return 0.5
+}
+
+// set sets the Address field of the template placeholder.
+func (p *ParsedAddress) set(placeholder, value string, confidence float64) {
+	name := addressFormFields[placeholder].name
+	var field *string
+	switch name {
+	case "Recipient":
+		field = &p.Recipient
+	case "Organization":
+		field = &p.Organization
+	case "Street":
+		field = &p.Street
+	case "Street2":
+		field = &p.Street2
+	case "DependentLocality":
+		field = &p.DependentLocality
+	case "City":
+		field = &p.City
+	case "PostalCode":
+		field = &p.PostalCode
+	case "SortingCode":
+		field = &p.SortingCode
+	case "Region":
+		field = &p.Region
+	default:
+		return
+	}
+	*field = value
+	p.Confidence[name] = confidence
+}
+
+// setExtraLines sets the lines that follow the recipient as the organization
+// and the ones that follow the street as the second street line and the
+// dependent locality.
+func (p *ParsedAddress) setExtraLines(placeholder string, lines []string) {
+	if placeholder == "recipient" {
+		p.set("organization", strings.Join(lines, "\n"), addressExtraLineConfidence)
+		return
+	}
+	p.set("street2", lines[0], addressExtraLineConfidence)
+	if len(lines) > 1 {
+		p.set("dependent_locality", strings.Join(lines[1:], "\n"), addressExtraLineConfidence)
+	}
+}
+
+// resolveParsedRegion replaces the parsed region with its subdivision code and
+// scores it against the postal code.
+func (c *Country) resolveParsedRegion(p *ParsedAddress) {
+	if p.Region == "" {
+		return
+	}
+	s := c.findSubdivision(p.Region)
+	if s.Code == "" {
+		return
+	}
+	p.Region = s.Code
+	if _, found := c.SubdivisionForPostalCode(p.PostalCode); found {
+		if c.CheckPostalCodeRegion(p.PostalCode, s.Code) == nil {
+			p.Confidence["Region"] = 1
+		} else {
+			p.Confidence["Region"] = 0.4
+		}
+	}
+}
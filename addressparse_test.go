@@ -0,0 +1,115 @@
+package countries_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddress(t *testing.T) {
+	us := countries.Get("US")
+	parsed := us.ParseAddress("John Smith\n1084 Nuzum Court\nBuffalo NY 14214\nUnited States of America")
+	assert.Equal(t, countries.Address{Recipient: "John Smith", Street: "1084 Nuzum Court", City: "Buffalo", Region: "NY", PostalCode: "14214"}, parsed.Address)
+	assert.Equal(t, 1.0, parsed.Confidence["PostalCode"])
+	assert.Equal(t, 1.0, parsed.Confidence["Region"])
+
+	parsed = us.ParseAddress("John Smith, 1084 Nuzum Court, Buffalo New York 14214, USA")
+	assert.Equal(t, countries.Address{Recipient: "John Smith", Street: "1084 Nuzum Court", City: "Buffalo", Region: "NY", PostalCode: "14214"}, parsed.Address)
+
+	// The region does not match the postal code.
+	parsed = us.ParseAddress("John Smith\n1084 Nuzum Court\nBuffalo CA 14214")
+	assert.Equal(t, "CA", parsed.Region)
+	assert.Less(t, parsed.Confidence["Region"], 0.5)
+
+	it := countries.Get("IT")
+	parsed = it.ParseAddress("Enrico Pilotto\nACME S.r.l.\nvia Garibaldi 15\nScala B\n97011 Acate RG\nItaly")
+	assert.Equal(t, countries.Address{
+		Recipient:    "Enrico Pilotto",
+		Organization: "ACME S.r.l.",
+		Street:       "via Garibaldi 15",
+		Street2:      "Scala B",
+		PostalCode:   "97011",
+		City:         "Acate",
+		Region:       "RG",
+	}, parsed.Address)
+	assert.Equal(t, 0.4, parsed.Confidence["Organization"])
+
+	// Missing fields.
+	parsed = it.ParseAddress("Enrico Pilotto\nvia Garibaldi 15\nAcate")
+	assert.Equal(t, countries.Address{Recipient: "Enrico Pilotto", Street: "via Garibaldi 15", City: "Acate"}, parsed.Address)
+	assert.NotContains(t, parsed.Confidence, "PostalCode")
+
+	assert.Equal(t, countries.ParsedAddress{Confidence: map[string]float64{}}, it.ParseAddress(" \n"))
+
+	// A city that is also the name of a region is the city when the region is
+	// optional.
+	gb := countries.Get("GB")
+	parsed = gb.ParseAddress("Jane Doe\n10 Downing Street\nLONDON\nSW1A 2AA\nUnited Kingdom")
+	assert.Equal(t, countries.Address{Recipient: "Jane Doe", Street: "10 Downing Street", City: "LONDON", PostalCode: "SW1A 2AA"}, parsed.Address)
+
+	// Postal codes are normalized before scoring.
+	ca := countries.Get("CA")
+	parsed = ca.ParseAddress("John Smith\n24 Sussex Drive\nOttawa ON k1m 1m4\nCanada")
+	assert.Equal(t, countries.Address{Recipient: "John Smith", Street: "24 Sussex Drive", City: "Ottawa", Region: "ON", PostalCode: "K1M 1M4"}, parsed.Address)
+	assert.Equal(t, 1.0, parsed.Confidence["PostalCode"])
+
+	// Regions are recognized by their names in the official languages.
+	jp := countries.Get("JP")
+	parsed = jp.ParseAddress("〒100-8111\n東京都千代田区千代田1-1\n山田太郎")
+	assert.Equal(t, countries.Address{Recipient: "山田太郎", Street: "千代田1-1", City: "千代田区", Region: "13", PostalCode: "100-8111"}, parsed.Address)
+}
+
+func TestParseAddressRoundTripFields(t *testing.T) {
+	tests := []struct {
+		alpha2  string
+		address countries.Address
+	}{
+		{"GB", countries.Address{Recipient: "Jane Doe", Street: "10 Downing Street", City: "LONDON", PostalCode: "SW1A 2AA"}},
+		{"GB", countries.Address{Recipient: "Jane Doe", Street: "1 Trinity Street", City: "CAMBRIDGE", Region: "CAM", PostalCode: "CB2 1TN"}},
+		{"CA", countries.Address{Recipient: "John Smith", Street: "24 Sussex Drive", City: "Ottawa", Region: "ON", PostalCode: "K1M 1M4"}},
+		{"JP", countries.Address{Recipient: "山田太郎", Street: "千代田1-1", City: "千代田区", Region: "13", PostalCode: "100-8111"}},
+	}
+	for _, test := range tests {
+		c := countries.Get(test.alpha2)
+		formatted := c.FormatStructuredAddress(test.address)
+		assert.Equal(t, test.address, c.ParseAddress(formatted).Address, formatted)
+	}
+}
+
+func TestParseAddressRoundTrip(t *testing.T) {
+	for _, c := range countries.All {
+		c := c
+		var region string
+		for _, s := range c.SubdivisionList(countries.SubdivisionListOptions{}) {
+			if len(s.Children()) == 0 && len(c.SubdivisionsByName(s.Name)) == 1 {
+				region = s.Code
+				break
+			}
+		}
+		var postalCode string
+		if c.HasPostalCode() {
+			postalCode = c.PostalCodeExample()
+		}
+		formatted := c.FormatAddress("Enrico Pilotto", "via Garibaldi 15", postalCode, "Santa Croce", region)
+		parsed := c.ParseAddress(formatted)
+		assert.Equal(t, formatted, c.FormatStructuredAddress(parsed.Address), c.Alpha2)
+		if formatted == "" {
+			continue
+		}
+		if strings.Contains(c.AddressFormat, "{{postalcode}}") {
+			assert.Equal(t, postalCode, parsed.PostalCode, c.Alpha2)
+		}
+		if strings.Contains(c.AddressFormat, "{{region") {
+			assert.Equal(t, region, parsed.Region, c.Alpha2)
+		}
+		// Japanese addresses join region, city and street without separators.
+		if c.Alpha2 == "JP" {
+			continue
+		}
+		assert.Equal(t, "Enrico Pilotto", parsed.Recipient, c.Alpha2)
+		assert.Equal(t, "via Garibaldi 15", parsed.Street, c.Alpha2)
+		assert.True(t, strings.EqualFold("Santa Croce", parsed.City), c.Alpha2)
+	}
+}
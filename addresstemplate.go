@@ -0,0 +1,326 @@
+package countries
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidAddressTemplate is returned when an address template contains an
+// unknown placeholder, an unknown transform or an unclosed placeholder.
+var ErrInvalidAddressTemplate = errors.New("countries: invalid address template")
+
+// addressFields lists the placeholders of an address template and whether they
+// accept the short and long transforms.
+var addressFields = map[string]bool{
+	"recipient":          false,
+	"organization":       false,
+	"street":             false,
+	"street2":            false,
+	"dependent_locality": false,
+	"city":               false,
+	"sorting_code":       false,
+	"postalcode":         false,
+	"region":             true,
+	"region_short":       true,
+	"country":            true,
+}
+
+// addressTransforms lists the transforms that can follow a placeholder field.
+var addressTransforms = map[string]bool{
+	"upper": true,
+	"lower": true,
+	"short": true,
+	"long":  true,
+}
+
+// AddressTemplate is a parsed address format, like country.AddressFormat.
+//
+// A template is made of lines of text with placeholders like {{city}}. A
+// placeholder can be followed by transforms separated by pipes, like
+// {{city|upper}}:
+//
+//	upper  uppercases the value
+//	lower  lowercases the value
+//	short  uses the code of the region or the alpha2 code of the country
+//	long   uses the name of the region or of the country
+//
+// The short and long transforms select the value, the last one winning, and
+// are applied before the others wherever they appear, so {{region|upper|short}}
+// is the uppercase code of the region.
+//
+// {{region}} is the name of the region and {{region_short}} its code, so
+// {{region|short}} and {{region_short}} are the same placeholder.
+type AddressTemplate struct {
+	lines  [][]addressToken
+	fields map[string]bool
+}
+
+// addressToken is a literal text or, if field is not empty, a placeholder.
+type addressToken struct {
+	text       string
+	field      string
+	transforms []string
+}
+
+// ParseAddressTemplate parses an address template. It returns an error
+// wrapping ErrInvalidAddressTemplate if the template contains an unknown
+// placeholder or transform.
+func ParseAddressTemplate(template string) (*AddressTemplate, error) {
+	return parseAddressTemplate(template, true)
+}
+
+// parseAddressTemplate parses an address template. Unless strict is true,
+// invalid placeholders are kept as literal text instead of returning an error.
+func parseAddressTemplate(template string, strict bool) (*AddressTemplate, error) {
+	t := &AddressTemplate{fields: make(map[string]bool)}
+	if template == "" {
+		return t, nil
+	}
+	for _, line := range strings.Split(template, "\n") {
+		var tokens []addressToken
+		for line != "" {
+			start := strings.Index(line, "{{")
+			if start < 0 {
+				tokens = appendAddressText(tokens, line)
+				break
+			}
+			if start > 0 {
+				tokens = appendAddressText(tokens, line[:start])
+			}
+			end := strings.Index(line[start:], "}}")
+			if end < 0 {
+				if strict {
+					return nil, fmt.Errorf("%w: unclosed placeholder %q", ErrInvalidAddressTemplate, line[start:])
+				}
+				tokens = appendAddressText(tokens, line[start:])
+				break
+			}
+			token, err := parseAddressPlaceholder(line[start+2 : start+end])
+			if err != nil {
+				if strict {
+					return nil, err
+				}
+				tokens = appendAddressText(tokens, line[start:start+end+2])
+				line = line[start+end+2:]
+				continue
+			}
+			t.fields[token.field] = true
+			if token.field == "region_short" {
+				token.field = "region"
+				token.transforms = append([]string{"short"}, token.transforms...)
+			}
+			tokens = append(tokens, token)
+			line = line[start+end+2:]
+		}
+		t.lines = append(t.lines, tokens)
+	}
+	return t, nil
+}
+
+// appendAddressText appends the literal text to tokens, merging it with the
+// last token if it is a literal text too.
+func appendAddressText(tokens []addressToken, text string) []addressToken {
+	if n := len(tokens); n > 0 && tokens[n-1].field == "" {
+		tokens[n-1].text += text
+		return tokens
+	}
+	return append(tokens, addressToken{text: text})
+}
+
+func parseAddressPlaceholder(s string) (addressToken, error) {
+	parts := strings.Split(s, "|")
+	token := addressToken{field: strings.TrimSpace(parts[0])}
+	shortLong, found := addressFields[token.field]
+	if !found {
+		return token, fmt.Errorf("%w: unknown placeholder %q", ErrInvalidAddressTemplate, token.field)
+	}
+	for _, transform := range parts[1:] {
+		transform = strings.TrimSpace(transform)
+		if !addressTransforms[transform] || (transform == "short" || transform == "long") && !shortLong {
+			return token, fmt.Errorf("%w: unknown transform %q for placeholder %q", ErrInvalidAddressTemplate, transform, token.field)
+		}
+		token.transforms = append(token.transforms, transform)
+	}
+	return token, nil
+}
+
+// Has reports whether the template contains a placeholder of the field, with
+// or without transforms.
+func (t *AddressTemplate) Has(field string) bool {
+	return t.fields[field]
+}
+
+// Format returns the address of the country formatted by the template.
+//
+// A placeholder with an empty value is removed together with the text that
+// separates it from the previous placeholder, or from the next one if it is
+// the first of its line, and lines left empty are removed. The organization,
+// the second street line, the dependent locality and the sorting code follow
+// the recipient, the street and the city if the template does not contain
+// their own placeholders.
+func (t *AddressTemplate) Format(c *Country, address Address) string {
+	return t.format(c, address, addressValue{long: c.ISOShortName, short: c.Alpha2}, "")
+}
+
+// format returns the address formatted by the template with the given value
+// of the country placeholder. If locale is not empty the region name is
+// translated when possible.
+func (t *AddressTemplate) format(c *Country, address Address, country addressValue, locale string) string {
+	values := t.values(c, address, country, locale)
+	var lines []string
+	for _, tokens := range t.lines {
+		if line := formatAddressLine(tokens, values); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// addressValue is the value of a placeholder field; short is the value used by
+// the short transform, if any.
+type addressValue struct {
+	long, short string
+}
+
+func (t *AddressTemplate) values(c *Country, address Address, country addressValue, locale string) map[string]addressValue {
+	optional := func(field, value string) string {
+		if t.Has(field) {
+			return ""
+		}
+		return value
+	}
+	subdivision := c.findSubdivision(address.Region)
+	region := addressValue{long: subdivision.localizedName(locale), short: subdivision.Code}
+	if region.long == "" {
+		region.long = address.Region
+	}
+	if region.short == "" {
+		region.short = address.Region
+	}
+	return map[string]addressValue{
+		"recipient":          {long: joinNonEmpty("\n", address.Recipient, optional("organization", address.Organization))},
+		"organization":       {long: address.Organization},
+		"street":             {long: joinNonEmpty("\n", address.Street, optional("street2", address.Street2), optional("dependent_locality", address.DependentLocality))},
+		"street2":            {long: address.Street2},
+		"dependent_locality": {long: address.DependentLocality},
+		"city":               {long: joinNonEmpty(" ", address.City, optional("sorting_code", address.SortingCode))},
+		"sorting_code":       {long: address.SortingCode},
+		"postalcode":         {long: address.PostalCode},
+		"region":             region,
+		"country":            country,
+	}
+}
+
+func (token addressToken) value(values map[string]addressValue) string {
+	v := values[token.field]
+	s := v.long
+	for _, transform := range token.transforms {
+		switch transform {
+		case "short":
+			s = v.short
+		case "long":
+			s = v.long
+		}
+	}
+	for _, transform := range token.transforms {
+		switch transform {
+		case "upper":
+			s = strings.ToUpper(s)
+		case "lower":
+			s = strings.ToLower(s)
+		}
+	}
+	return s
+}
+
+func formatAddressLine(tokens []addressToken, values map[string]addressValue) string {
+	out := make([]string, len(tokens))
+	drop := make([]bool, len(tokens))
+	placeholders, empty := 0, 0
+	for i, token := range tokens {
+		if token.field == "" {
+			out[i] = token.text
+			continue
+		}
+		placeholders++
+		out[i] = token.value(values)
+		if out[i] != "" {
+			continue
+		}
+		empty++
+		drop[i] = true
+		if i > 0 && tokens[i-1].field == "" && !drop[i-1] {
+			drop[i-1] = true
+		} else if i+1 < len(tokens) && tokens[i+1].field == "" {
+			drop[i+1] = true
+		}
+	}
+	if placeholders > 0 && placeholders == empty {
+		return ""
+	}
+	var b strings.Builder
+	for i, s := range out {
+		if !drop[i] {
+			b.WriteString(s)
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// withTransforms returns a copy of the template where the placeholders of the
+// fields in transforms apply those transforms before their own.
+func (t *AddressTemplate) withTransforms(transforms map[string][]string) *AddressTemplate {
+	if len(transforms) == 0 {
+		return t
+	}
+	result := &AddressTemplate{lines: make([][]addressToken, len(t.lines)), fields: t.fields}
+	for i, tokens := range t.lines {
+		result.lines[i] = make([]addressToken, len(tokens))
+		for j, token := range tokens {
+			if extra := transforms[token.field]; len(extra) > 0 {
+				token.transforms = append(append([]string(nil), extra...), token.transforms...)
+			}
+			result.lines[i][j] = token
+		}
+	}
+	return result
+}
+
+// addressTemplateKey identifies a parsed address template: the same format
+// can have different transforms in different countries. The templates without
+// transforms have an empty alpha2.
+type addressTemplateKey struct {
+	alpha2, format string
+}
+
+var addressTemplates sync.Map
+
+// addressTemplate returns the parsed address template of the country. Templates
+// are parsed once; the country address formats are checked by the generator,
+// while invalid placeholders of custom formats are kept as literal text.
+func (c *Country) addressTemplate() *AddressTemplate {
+	return loadAddressTemplate(addressTemplateKey{format: c.AddressFormat}, nil)
+}
+
+// postalAddressTemplate returns the parsed address template of the country
+// with the transforms required by its postal service applied.
+func (c *Country) postalAddressTemplate() *AddressTemplate {
+	transforms := addressFormatTransforms[c.Alpha2]
+	if len(transforms) == 0 {
+		return c.addressTemplate()
+	}
+	return loadAddressTemplate(addressTemplateKey{c.Alpha2, c.AddressFormat}, transforms)
+}
+
+func loadAddressTemplate(key addressTemplateKey, transforms map[string][]string) *AddressTemplate {
+	if t, found := addressTemplates.Load(key); found {
+		return t.(*AddressTemplate)
+	}
+	// parseAddressTemplate does not return errors if not strict.
+	t, _ := parseAddressTemplate(key.format, false)
+	t = t.withTransforms(transforms)
+	v, _ := addressTemplates.LoadOrStore(key, t)
+	return v.(*AddressTemplate)
+}
@@ -0,0 +1,104 @@
+package countries_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAddressTemplate(t *testing.T) {
+	for _, c := range countries.All {
+		_, err := countries.ParseAddressTemplate(c.AddressFormat)
+		assert.Nil(t, err, c.Alpha2)
+	}
+
+	tmpl, err := countries.ParseAddressTemplate("{{recipient}}\n{{ city | upper }} {{region|short}}")
+	if assert.Nil(t, err) {
+		assert.True(t, tmpl.Has("city"))
+		assert.True(t, tmpl.Has("region"))
+		assert.False(t, tmpl.Has("region_short"))
+	}
+
+	for _, template := range []string{
+		"{{recipient}}\n{{zip}}",
+		"{{city|title}}",
+		"{{city|short}}",
+		"{{recipient}}\n{{city",
+	} {
+		_, err := countries.ParseAddressTemplate(template)
+		assert.True(t, errors.Is(err, countries.ErrInvalidAddressTemplate), template)
+	}
+}
+
+func TestAddressTemplateFormat(t *testing.T) {
+	us := countries.Get("US")
+	address := countries.Address{Recipient: "John Smith", Street: "1084 Nuzum Court", PostalCode: "14214", City: "Buffalo"}
+	assert.Equal(t, "John Smith\n1084 Nuzum Court\nBuffalo 14214\nUnited States of America", us.FormatStructuredAddress(address))
+
+	es := countries.Get("ES")
+	address = countries.Address{Recipient: "Enrico Pilotto", Street: "via Garibaldi 15", PostalCode: "97011", City: "Acate"}
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n97011 Acate\nSpain", es.FormatStructuredAddress(address))
+
+	it := countries.Get("IT")
+	address = countries.Address{Recipient: "Enrico Pilotto", Street: "via Garibaldi 15", City: "Acate", Region: "RG"}
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\nAcate RG\nItaly", it.FormatStructuredAddress(address))
+
+	fr := countries.Get("FR")
+	address = countries.Address{Recipient: "Jean Dupont", Street: "8 rue de Rivoli", PostalCode: "75001", City: "Paris", SortingCode: "Cedex 01"}
+	assert.Equal(t, "Jean Dupont\n8 rue de Rivoli\n75001 Paris Cedex 01\nFrance", fr.FormatStructuredAddress(address))
+	assert.Equal(t, "Jean Dupont\n8 rue de Rivoli\n75001 Paris\nFrance", fr.FormatAddress("Jean Dupont", "8 rue de Rivoli", "75001", "Paris", ""))
+
+	tmpl, err := countries.ParseAddressTemplate("{{organization|upper}}\n{{recipient}}\n{{city}}, {{region|short}} {{postalcode}}\n{{country|short|lower}}")
+	if assert.Nil(t, err) {
+		address = countries.Address{Recipient: "John Smith", Organization: "Acme Inc.", PostalCode: "14214", City: "Buffalo", Region: "New York"}
+		assert.Equal(t, "ACME INC.\nJohn Smith\nBuffalo, NY 14214\nus", tmpl.Format(us, address))
+		address = countries.Address{Recipient: "John Smith", PostalCode: "14214", City: "Buffalo"}
+		assert.Equal(t, "John Smith\nBuffalo 14214\nus", tmpl.Format(us, address))
+		address = countries.Address{Recipient: "John Smith", Region: "NY"}
+		assert.Equal(t, "John Smith\nNY\nus", tmpl.Format(us, address))
+	}
+
+	tmpl, err = countries.ParseAddressTemplate("{{region_short}} {{region_short|long|upper}}")
+	if assert.Nil(t, err) {
+		assert.True(t, tmpl.Has("region_short"))
+		assert.Equal(t, "NY NEW YORK", tmpl.Format(us, countries.Address{Region: "New York"}))
+	}
+
+	// The short and long transforms are applied first.
+	tmpl, err = countries.ParseAddressTemplate("{{region|lower|short}} {{region_short|upper|long}} {{country|lower|short}}")
+	if assert.Nil(t, err) {
+		assert.Equal(t, "ny NEW YORK us", tmpl.Format(us, countries.Address{Region: "New York"}))
+	}
+
+	// Invalid placeholders of custom formats are kept as literal text.
+	c := countries.Country{Alpha2: "US", AddressFormat: "{{recipient}}\n{{zip}} {{city}}\n{{country"}
+	address = countries.Address{Recipient: "John Smith", City: "Buffalo"}
+	assert.Equal(t, "John Smith\n{{zip}} Buffalo\n{{country", c.FormatStructuredAddress(address))
+}
+
+func TestAddressTemplateTransforms(t *testing.T) {
+	// The uppercase city of GB addresses comes from the country data, not from
+	// the address format, and only postal addresses apply it.
+	gb := countries.Get("GB")
+	assert.NotContains(t, gb.AddressFormat, "upper")
+	address := countries.Address{Recipient: "Jane Doe", Street: "10 Downing Street", PostalCode: "SW1A 2AA", City: "London"}
+	assert.Equal(t, "Jane Doe\n10 Downing Street\nLondon\nSW1A 2AA", gb.FormatAddressFor(address, gb, ""))
+	assert.Equal(t, "Jane Doe\n10 Downing Street\nLONDON\nSW1A 2AA", gb.FormatPostalAddress(address, gb, ""))
+	assert.Equal(t, "Jane Doe\n10 Downing Street\nLONDON\nSW1A 2AA\nRegno Unito", gb.FormatPostalAddress(address, countries.Get("IT"), "it"))
+	assert.Equal(t, "Jane Doe\n10 Downing Street\nLondon\nSW1A 2AA\nUnited Kingdom of Great Britain and Northern Ireland", gb.FormatAddress("Jane Doe", "10 Downing Street", "SW1A 2AA", "London", ""))
+
+	fr := countries.Get("FR")
+	address = countries.Address{Recipient: "Jean Dupont", Street: "8 rue de Rivoli", PostalCode: "75001", City: "Paris", SortingCode: "Cedex 01"}
+	assert.Equal(t, "Jean Dupont\n8 rue de Rivoli\n75001 PARIS CEDEX 01", fr.FormatPostalAddress(address, fr, ""))
+
+	// Countries without transforms format postal addresses as the others.
+	it := countries.Get("IT")
+	address = countries.Address{Recipient: "Enrico Pilotto", Street: "via Garibaldi 15", PostalCode: "97011", City: "Acate", Region: "RG"}
+	assert.Equal(t, it.FormatAddressFor(address, nil, ""), it.FormatPostalAddress(address, nil, ""))
+
+	c := countries.Country{Alpha2: "XX", AddressFormat: gb.AddressFormat}
+	address = countries.Address{Recipient: "Jane Doe", Street: "10 Downing Street", PostalCode: "SW1A 2AA", City: "London"}
+	assert.Equal(t, "Jane Doe\n10 Downing Street\nLondon\nSW1A 2AA", c.FormatPostalAddress(address, &c, ""))
+}
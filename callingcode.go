@@ -0,0 +1,79 @@
+package countries
+
+import (
+	"strings"
+
+	"github.com/pioz/countries/internal/phonedigits"
+)
+
+// FromPhoneNumber returns the countries a phone number in international format
+// can belong to. The number must start with "+" or "00" and can contain
+// spaces, dashes, dots, slashes and parentheses. The country calling code is
+// found by longest prefix match. Numbers of the North American Numbering Plan
+// (calling code 1) are resolved by their area code, from the numbering plans
+// of the data directory: toll-free codes belong to both the United States and
+// Canada, while unknown area codes are an error. For other calling codes
+// shared by more countries, like 7 or 44, all the candidates are returned,
+// ordered by alpha2 code. Returned errors wrap ErrInvalidFormat or
+// ErrUnknownCode and can be checked with errors.Is.
+func FromPhoneNumber(number string) ([]*Country, error) {
+	digits, ok := internationalDigits(number)
+	if !ok {
+		return nil, parseError(number, ErrInvalidFormat)
+	}
+	for length := 3; length > 0; length-- {
+		if len(digits) < length {
+			continue
+		}
+		code := digits[:length]
+		var candidates []*Country
+		for i := range All {
+			if All[i].CountryCode == code {
+				candidates = append(candidates, &All[i])
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		if code == "1" && len(digits) >= 4 {
+			candidates = nanpCountries(digits, candidates)
+			if len(candidates) == 0 {
+				return nil, parseError(number, ErrUnknownCode)
+			}
+		}
+		return candidates, nil
+	}
+	return nil, parseError(number, ErrUnknownCode)
+}
+
+// nanpCountries returns the countries of the NANP number in digits, calling
+// code included, among candidates: the country whose NANP prefix matches or
+// the countries of the area code. Unknown area codes have no countries.
+func nanpCountries(digits string, candidates []*Country) []*Country {
+	for _, c := range candidates {
+		if strings.HasPrefix(c.NANPPrefix, c.CountryCode) && strings.HasPrefix(digits, c.NANPPrefix) {
+			return []*Country{c}
+		}
+	}
+	var result []*Country
+	for _, alpha2 := range nanpAreaCodes[digits[1:4]] {
+		result = append(result, Get(alpha2))
+	}
+	return result
+}
+
+// internationalDigits returns the digits of number, without the leading "+" or
+// "00". If number is not in international format, contains characters other
+// than digits and punctuation or has no digits, ok is false.
+func internationalDigits(number string) (digits string, ok bool) {
+	digits, plus, ok := phonedigits.Extract(number)
+	switch {
+	case !ok:
+		return "", false
+	case plus:
+		return digits, true
+	case strings.HasPrefix(strings.TrimSpace(number), "00") && len(digits) > 2:
+		return digits[2:], true
+	}
+	return "", false
+}
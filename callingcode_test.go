@@ -0,0 +1,61 @@
+package countries_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func alpha2Codes(list []*countries.Country) []string {
+	result := make([]string, len(list))
+	for i, c := range list {
+		result[i] = c.Alpha2
+	}
+	return result
+}
+
+func TestFromPhoneNumber(t *testing.T) {
+	for number, expected := range map[string][]string{
+		"+39 333 1234567":   {"IT", "VA"},
+		"+7 495 123 4567":   {"KZ", "RU"},
+		"+44 20 7946 0018":  {"GB", "GG", "IM", "JE"},
+		"0049 30 123456":    {"DE"},
+		"+1 (212) 555-1234": {"US"},
+		"+1 416 555 1234":   {"CA"},
+		"+1 268 460 1234":   {"AG"},
+		"+1-787-555-1234":   {"PR"},
+		"+1 939 555 1234":   {"PR"},
+		"+1 829 555 1234":   {"DO"},
+		"+1 709 555 1234":   {"CA"},
+		"+1 800 555 1234":   {"CA", "US"},
+		"+378 0549 123456":  {"SM"},
+		"+1":                {"AG", "AI", "AS", "BB", "BM", "BS", "CA", "DM", "DO", "GD", "GU", "JM", "KN", "KY", "LC", "MP", "MS", "PR", "SX", "TC", "TT", "UM", "US", "VC", "VG", "VI"},
+	} {
+		list, err := countries.FromPhoneNumber(number)
+		assert.Nil(t, err, number)
+		assert.Equal(t, expected, alpha2Codes(list), number)
+	}
+
+	for _, number := range []string{"", "+", "39 333 1234567", "+39 333 12x", "++39"} {
+		_, err := countries.FromPhoneNumber(number)
+		assert.True(t, errors.Is(err, countries.ErrInvalidFormat), number)
+	}
+	_, err := countries.FromPhoneNumber("+999 123")
+	assert.True(t, errors.Is(err, countries.ErrUnknownCode))
+	// Unknown NANP area codes do not default to the United States.
+	_, err = countries.FromPhoneNumber("+1 999 555 1234")
+	assert.True(t, errors.Is(err, countries.ErrUnknownCode))
+}
+
+func ExampleFromPhoneNumber() {
+	list, _ := countries.FromPhoneNumber("+1 416 555 1234")
+	fmt.Println(list[0].Alpha2)
+	list, _ = countries.FromPhoneNumber("+7 495 123 4567")
+	fmt.Println(len(list))
+	// Output:
+	// CA
+	// 2
+}
@@ -3,8 +3,11 @@
 package countries
 
 import (
+	"errors"
+	"fmt"
 	"regexp"
 	"strings"
+	"sync"
 )
 
 // Coord represents a geographic coordinate.
@@ -39,17 +42,45 @@ type VatRates struct {
 	Parking      int   `yaml:"parking"`
 }
 
+// DistanceUnit is the unit of measure used for distances in a country.
+type DistanceUnit string
+
+// Distance units.
+const (
+	Kilometers DistanceUnit = "KM"
+	Miles      DistanceUnit = "MI"
+)
+
+// String returns the name of the distance unit.
+func (u DistanceUnit) String() string {
+	switch u {
+	case Kilometers:
+		return "kilometers"
+	case Miles:
+		return "miles"
+	}
+	return string(u)
+}
+
+// SubdivisionType is the kind of a subdivision, like "state" or "province".
+// The complete set of types found in the subdivision data is generated as
+// constants and listed in SubdivisionTypes.
+type SubdivisionType string
+
 // Country store all information about a country.
 type Country struct {
 	AddressFormat                  string                 `yaml:"address_format"`
 	Alpha2                         string                 `yaml:"alpha2"`
 	Alpha3                         string                 `yaml:"alpha3"`
+	AltCurrency                    string                 `yaml:"alt_currency"`
 	Capital                        string                 `yaml:"capital"`
 	Continent                      string                 `yaml:"continent"`
 	CountryCode                    string                 `yaml:"country_code"`
 	CurrencyCode                   string                 `yaml:"currency_code"`
+	DistanceUnit                   DistanceUnit           `yaml:"distance_unit"`
 	EEAMember                      bool                   `yaml:"eea_member"`
 	EUMember                       bool                   `yaml:"eu_member"`
+	EUVATMember                    bool                   `yaml:"euvat_member"`
 	G7Member                       bool                   `yaml:"g7_member"`
 	G20Member                      bool                   `yaml:"g20_member"`
 	ESMMember                      bool                   `yaml:"esm_member"`
@@ -62,11 +93,13 @@ type Country struct {
 	ISOShortNameLowerCase          string                 `yaml:"iso_short_name_lower_case"`
 	LanguagesOfficial              []string               `yaml:"languages_official"`
 	LanguagesSpoken                []string               `yaml:"languages_spoken"`
+	NANPPrefix                     string                 `yaml:"nanp_prefix"`
 	NationalDestinationCodeLengths []int                  `yaml:"national_destination_code_lengths"`
 	NationalNumberLengths          []int                  `yaml:"national_number_lengths"`
 	NationalPrefix                 string                 `yaml:"national_prefix"`
 	Nationality                    string                 `yaml:"nationality"`
 	Number                         string                 `yaml:"number"`
+	PostalCode                     bool                   `yaml:"postal_code"`
 	PostalCodeFormat               string                 `yaml:"postal_code_format"`
 	Region                         string                 `yaml:"region"`
 	StartOfWeek                    string                 `yaml:"start_of_week"`
@@ -75,20 +108,26 @@ type Country struct {
 	Timezones                      []string               `yaml:"-"`
 	Translations                   map[string]string      `yaml:"-"`
 	UnLocode                       string                 `yaml:"un_locode"`
+	UNMember                       bool                   `yaml:"un_member"`
 	UnofficialNames                []string               `yaml:"unofficial_names"`
 	VatRates                       VatRates               `yaml:"vat_rates"`
+	VehicleRegistrationCode        string                 `yaml:"vehicle_registration_code"`
 	WorldRegion                    string                 `yaml:"world_region"`
 }
 
 // Subdivision store information about a subdivision like a region or a province
 // or a state or a metropolitan city of a country.
 type Subdivision struct {
-	Name         string            `yaml:"name"`
-	Code         string            `yaml:"code"`
-	Type         string            `yaml:"type"`
-	Capital      bool              `yaml:"capital"`
-	Geo          Geo               `yaml:"geo"`
-	Translations map[string]string `yaml:"translations"`
+	Name            string            `yaml:"name"`
+	Code            string            `yaml:"code"`
+	CountryAlpha2   string            `yaml:"-"`
+	ParentCode      string            `yaml:"-"`
+	Type            string            `yaml:"type"`
+	Capital         bool              `yaml:"capital"`
+	Geo             Geo               `yaml:"geo"`
+	Translations    map[string]string `yaml:"translations"`
+	UnofficialNames []string          `yaml:"unofficial_names"`
+	Comments        string            `yaml:"comments"`
 }
 
 // InEU returns all countries that are members of the European Union.
@@ -124,18 +163,77 @@ func InSubregion(subregion string) []Country {
 	return result
 }
 
+// CodeKind identifies the code system a country code belongs to.
+type CodeKind int
+
+// Code systems recognized by Lookup.
+const (
+	CodeUnknown CodeKind = iota
+	CodeAlpha2
+	CodeAlpha3
+	CodeNumeric
+	CodeIOC
+	CodeGEC
+	CodeUnLocode
+)
+
+var codeKindNames = [...]string{
+	CodeUnknown:  "unknown",
+	CodeAlpha2:   "alpha2",
+	CodeAlpha3:   "alpha3",
+	CodeNumeric:  "numeric",
+	CodeIOC:      "ioc",
+	CodeGEC:      "gec",
+	CodeUnLocode: "un_locode",
+}
+
+// String returns the name of the code system.
+func (k CodeKind) String() string {
+	if k < 0 || int(k) >= len(codeKindNames) {
+		return codeKindNames[CodeUnknown]
+	}
+	return codeKindNames[k]
+}
+
+// Lookup returns the country identified by code and the code system the code
+// belongs to. Since different code systems share the same code space, the
+// systems are tried in this order: alpha2, alpha3, numeric, IOC, GEC and
+// UN/LOCODE. If no country is found returns nil and CodeUnknown.
+func Lookup(code string) (*Country, CodeKind) {
+	finders := []struct {
+		kind CodeKind
+		get  func(string) *Country
+	}{
+		{CodeAlpha2, Get},
+		{CodeAlpha3, GetByAlpha3},
+		{CodeNumeric, GetByNumeric},
+		{CodeIOC, GetByIOC},
+		{CodeGEC, GetByGEC},
+		{CodeUnLocode, GetByUnLocode},
+	}
+	for _, f := range finders {
+		if c := f.get(code); c != nil {
+			return c, f.kind
+		}
+	}
+	return nil, CodeUnknown
+}
+
 // Subdivision returns the country's subdivision identified by code. If the code
 // is not valid or not found returns a zero value Subdivision.
 func (c *Country) Subdivision(code string) Subdivision {
 	return c.Subdivisions[code]
 }
 
-// SubdivisionByName returns the country's subdivision with name name. If the
-// name is not valid or not found returns a zero value Subdivision.
-func (c *Country) SubdivisionByName(name string) Subdivision {
-	for _, s := range c.Subdivisions {
-		if s.Name == name {
-			return s
+// SubdivisionByName returns the country's subdivision with the given name. The
+// name can be the subdivision name, an unofficial name or a translation in any
+// locale and is matched ignoring case, diacritics and punctuation. If locales
+// are given, only translations in those locales are considered. If the name is
+// not valid or not found returns a zero value Subdivision.
+func (c *Country) SubdivisionByName(name string, locales ...string) Subdivision {
+	for _, e := range subdivisionNames(c.Alpha2)[NormalizeName(name)] {
+		if len(locales) == 0 || containsString(locales, e.Locale) {
+			return c.Subdivisions[e.Code]
 		}
 	}
 	return Subdivision{}
@@ -148,39 +246,99 @@ func (c *Country) HasPostalCode() bool {
 }
 
 // MatchPostalCode returns true if postalCode has a valid format for the
-// country. If the country does not have a postal code, returns false.
+// country. The whole postalCode must match the country's PostalCodeFormat. If
+// the country does not have a postal code, returns false.
 func (c *Country) MatchPostalCode(postalCode string) bool {
 	if !c.HasPostalCode() {
 		return false
 	}
-	r := regexp.MustCompile(c.PostalCodeFormat)
-	return r.Match([]byte(postalCode))
+	return postalCodeRegexp(c.PostalCodeFormat).MatchString(postalCode)
+}
+
+var (
+	// ErrNoPostalCode is returned when the country does not have postal
+	// codes.
+	ErrNoPostalCode = errors.New("countries: country has no postal codes")
+	// ErrInvalidPostalCode is returned when the postal code does not match the
+	// format of the country.
+	ErrInvalidPostalCode = errors.New("countries: invalid postal code")
+)
+
+// ValidatePostalCode returns nil if postalCode has a valid format for the
+// country, like MatchPostalCode. Otherwise returned errors wrap ErrNoPostalCode
+// or ErrInvalidPostalCode and can be checked with errors.Is.
+func (c *Country) ValidatePostalCode(postalCode string) error {
+	if !c.HasPostalCode() {
+		return fmt.Errorf("%w: %s", ErrNoPostalCode, c.Alpha2)
+	}
+	if !c.MatchPostalCode(postalCode) {
+		return c.invalidPostalCode(postalCode)
+	}
+	return nil
+}
+
+// NormalizePostalCode returns postalCode in the canonical layout of the
+// country, like "SW1A 1AA" for "sw1a1aa" in the United Kingdom or "01001-000"
+// for "01001000" in Brazil. The postal code is upper cased and its spaces and
+// dashes are removed; then, if the country format accepts a separator, a dash
+// or a space is inserted where the format expects it. Returned errors are the
+// same as ValidatePostalCode.
+func (c *Country) NormalizePostalCode(postalCode string) (string, error) {
+	if !c.HasPostalCode() {
+		return "", fmt.Errorf("%w: %s", ErrNoPostalCode, c.Alpha2)
+	}
+	compact := compactPostalCode(postalCode)
+	r := postalCodeRegexp(c.PostalCodeFormat)
+	for i := 1; i < len(compact); i++ {
+		for _, sep := range []string{"-", " "} {
+			if s := compact[:i] + sep + compact[i:]; r.MatchString(s) {
+				return s, nil
+			}
+		}
+	}
+	if r.MatchString(compact) {
+		return compact, nil
+	}
+	return "", c.invalidPostalCode(postalCode)
+}
+
+func (c *Country) invalidPostalCode(postalCode string) error {
+	return fmt.Errorf("%w: %q does not match the %s format %s", ErrInvalidPostalCode, postalCode, c.Alpha2, c.PostalCodeFormat)
+}
+
+// postalCodeRegexps caches the compiled postal code formats.
+var postalCodeRegexps sync.Map
+
+// postalCodeRegexp returns the compiled regexp of the postal code format,
+// anchored to match the whole string. Formats are compiled once.
+func postalCodeRegexp(format string) *regexp.Regexp {
+	if r, found := postalCodeRegexps.Load(format); found {
+		return r.(*regexp.Regexp)
+	}
+	r, _ := postalCodeRegexps.LoadOrStore(format, regexp.MustCompile(`^(?:`+format+`)$`))
+	return r.(*regexp.Regexp)
 }
 
 // FormatAddress returns the formatted address based on country.AddressFormat
-// template.
+// template. It is a shortcut of FormatStructuredAddress.
 func (c *Country) FormatAddress(recipient, street, postalCode, city, region string) string {
+	return c.FormatStructuredAddress(Address{
+		Recipient:  recipient,
+		Street:     street,
+		PostalCode: postalCode,
+		City:       city,
+		Region:     region,
+	})
+}
+
+// findSubdivision returns the country's subdivision identified by region, a
+// code or a name. If the region is not found returns a zero value Subdivision.
+func (c *Country) findSubdivision(region string) Subdivision {
 	subdivision := c.Subdivision(region)
 	if subdivision.Name == "" {
 		subdivision = c.SubdivisionByName(region)
 	}
-	regionName := subdivision.Name
-	regionShortName := subdivision.Code
-	if regionName == "" {
-		regionName = region
-	}
-	if regionShortName == "" {
-		regionShortName = region
-	}
-	a := c.AddressFormat
-	a = strings.ReplaceAll(a, "{{recipient}}", recipient)
-	a = strings.ReplaceAll(a, "{{street}}", street)
-	a = strings.ReplaceAll(a, "{{postalcode}}", postalCode)
-	a = strings.ReplaceAll(a, "{{city}}", city)
-	a = strings.ReplaceAll(a, "{{region}}", regionName)
-	a = strings.ReplaceAll(a, "{{region_short}}", regionShortName)
-	a = strings.ReplaceAll(a, "{{country}}", c.ISOShortName)
-	return a
+	return subdivision
 }
 
 // GDPRCompliant returns true if the country is GDPR (General Data Protection
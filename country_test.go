@@ -1,6 +1,7 @@
 package countries_test
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -18,12 +19,15 @@ func TestCountry(t *testing.T) {
 	assert.Equal(t, "{{recipient}}\n{{street}}\n{{postalcode}} {{city}} {{region_short}}\n{{country}}", c.AddressFormat)
 	assert.Equal(t, "IT", c.Alpha2)
 	assert.Equal(t, "ITA", c.Alpha3)
+	assert.Equal(t, "", c.AltCurrency)
 	assert.Equal(t, "Rome", c.Capital)
 	assert.Equal(t, "Europe", c.Continent)
 	assert.Equal(t, "39", c.CountryCode)
 	assert.Equal(t, "EUR", c.CurrencyCode)
+	assert.Equal(t, countries.Kilometers, c.DistanceUnit)
 	assert.Equal(t, true, c.EEAMember)
 	assert.Equal(t, true, c.EUMember)
+	assert.Equal(t, false, c.EUVATMember)
 	assert.Equal(t, true, c.G7Member)
 	assert.Equal(t, true, c.G20Member)
 	assert.Equal(t, false, c.ESMMember)
@@ -45,11 +49,13 @@ func TestCountry(t *testing.T) {
 	assert.Equal(t, "Italy", c.ISOShortNameLowerCase)
 	assert.Equal(t, []string{"it"}, c.LanguagesOfficial)
 	assert.Equal(t, []string{"it"}, c.LanguagesSpoken)
+	assert.Equal(t, "", c.NANPPrefix)
 	assert.Equal(t, []int{3}, c.NationalDestinationCodeLengths)
 	assert.Equal(t, []int{9, 11}, c.NationalNumberLengths)
 	assert.Equal(t, "None", c.NationalPrefix)
 	assert.Equal(t, "Italian", c.Nationality)
 	assert.Equal(t, "380", c.Number)
+	assert.Equal(t, true, c.PostalCode)
 	assert.Equal(t, "\\d{5}", c.PostalCodeFormat)
 	assert.Equal(t, "Europe", c.Region)
 	assert.Equal(t, "monday", c.StartOfWeek)
@@ -58,14 +64,40 @@ func TestCountry(t *testing.T) {
 	assert.Equal(t, 1, len(c.Timezones))
 	assert.Equal(t, "Europe/Rome", c.Timezones[0])
 	assert.Equal(t, "IT", c.UnLocode)
+	assert.Equal(t, true, c.UNMember)
 	assert.Equal(t, []string{"Italy", "Italien", "Italie", "Italia", "イタリア", "Italië"}, c.UnofficialNames)
 	assert.Equal(t, 22, c.VatRates.Standard)
 	assert.Equal(t, []int{10}, c.VatRates.Reduced)
 	assert.Equal(t, 4, c.VatRates.SuperReduced)
 	assert.Equal(t, 0, c.VatRates.Parking)
+	assert.Equal(t, "I", c.VehicleRegistrationCode)
 	assert.Equal(t, "EMEA", c.WorldRegion)
 }
 
+func TestCountryExtraFields(t *testing.T) {
+	c := countries.Get("US")
+	assert.Equal(t, countries.Miles, c.DistanceUnit)
+	assert.Equal(t, "miles", c.DistanceUnit.String())
+	assert.Equal(t, "USA", c.VehicleRegistrationCode)
+
+	c = countries.Get("JM")
+	assert.Equal(t, "1876", c.NANPPrefix)
+	assert.Equal(t, false, c.PostalCode)
+
+	c = countries.Get("PA")
+	assert.Equal(t, "USD", c.AltCurrency)
+
+	c = countries.Get("VA")
+	assert.Equal(t, false, c.UNMember)
+
+	assert.Equal(t, "see also separate entry under GU", countries.Get("US").Subdivision("GU").Comments)
+
+	for _, c := range countries.All {
+		assert.Contains(t, []countries.DistanceUnit{countries.Kilometers, countries.Miles}, c.DistanceUnit)
+		assert.Equal(t, c.PostalCode, c.HasPostalCode(), c.Alpha2)
+	}
+}
+
 func ExampleGet() {
 	c := countries.Get("US")
 	fmt.Println(c.ISOShortName)
@@ -287,6 +319,54 @@ func TestSubregions(t *testing.T) {
 	assert.Equal(t, "Western Europe", subregions[21])
 }
 
+func TestGetByCode(t *testing.T) {
+	assert.Equal(t, "IT", countries.GetByAlpha3("ITA").Alpha2)
+	assert.Equal(t, "IT", countries.GetByNumeric("380").Alpha2)
+	assert.Equal(t, "DE", countries.GetByIOC("GER").Alpha2)
+	assert.Equal(t, "DE", countries.GetByGEC("GM").Alpha2)
+	assert.Equal(t, "IT", countries.GetByUnLocode("IT").Alpha2)
+
+	assert.Nil(t, countries.GetByAlpha3("XXX"))
+	assert.Nil(t, countries.GetByNumeric("999"))
+	assert.Nil(t, countries.GetByIOC(""))
+	assert.Nil(t, countries.GetByGEC(""))
+	assert.Nil(t, countries.GetByUnLocode("XX"))
+}
+
+func TestLookup(t *testing.T) {
+	c, kind := countries.Lookup("IT")
+	assert.Equal(t, "IT", c.Alpha2)
+	assert.Equal(t, countries.CodeAlpha2, kind)
+
+	c, kind = countries.Lookup("USA")
+	assert.Equal(t, "US", c.Alpha2)
+	assert.Equal(t, countries.CodeAlpha3, kind)
+
+	c, kind = countries.Lookup("840")
+	assert.Equal(t, "US", c.Alpha2)
+	assert.Equal(t, countries.CodeNumeric, kind)
+
+	c, kind = countries.Lookup("GER")
+	assert.Equal(t, "DE", c.Alpha2)
+	assert.Equal(t, countries.CodeIOC, kind)
+
+	c, kind = countries.Lookup("AA")
+	assert.Equal(t, "AW", c.Alpha2)
+	assert.Equal(t, countries.CodeGEC, kind)
+
+	c, kind = countries.Lookup("XX")
+	assert.Nil(t, c)
+	assert.Equal(t, countries.CodeUnknown, kind)
+	assert.Equal(t, "unknown", kind.String())
+	assert.Equal(t, "ioc", countries.CodeIOC.String())
+}
+
+func ExampleLookup() {
+	c, kind := countries.Lookup("GER")
+	fmt.Println(c.Alpha2, kind)
+	// Output: DE ioc
+}
+
 func TestInEU(t *testing.T) {
 	cc := countries.InEU()
 	assert.Equal(t, 34, len(cc))
@@ -319,6 +399,7 @@ func TestSubdivision(t *testing.T) {
 	assert.Equal(t, 12.4963655, subdivision.Geo.Longitude)
 	assert.Equal(t, "Rome", subdivision.Translations["en"])
 	assert.Equal(t, "metropolitan_city", subdivision.Type)
+	assert.Equal(t, countries.SubdivisionTypeMetropolitanCity, subdivision.Kind())
 	assert.True(t, subdivision.Capital)
 }
 
@@ -336,6 +417,20 @@ func TestSubdivisionByName(t *testing.T) {
 	assert.Equal(t, "Veneto", subdivision.Name)
 	subdivision = c.SubdivisionByName("xx")
 	assert.Equal(t, "", subdivision.Name)
+	subdivision = c.SubdivisionByName("")
+	assert.Equal(t, "", subdivision.Name)
+
+	for _, name := range []string{"Piemonte", "Piedmont", "Piémont", "piemont", "PIEMONTE"} {
+		assert.Equal(t, "21", c.SubdivisionByName(name).Code, name)
+	}
+	de := countries.Get("DE")
+	assert.Equal(t, "BY", de.SubdivisionByName("Bavaria").Code)
+	assert.Equal(t, "BY", de.SubdivisionByName("baviere").Code)
+	assert.Equal(t, "BY", de.SubdivisionByName("Bavière", "fr").Code)
+	assert.Equal(t, "", de.SubdivisionByName("Bavière", "de").Code)
+
+	// Name clashes are resolved in favor of the subdivision code order.
+	assert.Equal(t, "NA", countries.Get("ES").SubdivisionByName("Navarra").Code)
 }
 
 func ExampleCountry_SubdivisionByName() {
@@ -365,6 +460,13 @@ func TestMatchPostalCode(t *testing.T) {
 	it := countries.Get("IT")
 	assert.True(t, it.MatchPostalCode("35018"))
 	assert.False(t, it.MatchPostalCode("3501F"))
+	assert.False(t, it.MatchPostalCode("x35018y"))
+	assert.False(t, it.MatchPostalCode("350189"))
+	assert.False(t, it.MatchPostalCode(""))
+
+	gb := countries.Get("GB")
+	assert.True(t, gb.MatchPostalCode("SW1A 1AA"))
+	assert.False(t, gb.MatchPostalCode("SW1A 1AA!"))
 
 	jm := countries.Get("JM")
 	assert.False(t, jm.MatchPostalCode("35018"))
@@ -376,6 +478,61 @@ func TestMatchPostalCode(t *testing.T) {
 	}
 }
 
+func TestValidatePostalCode(t *testing.T) {
+	it := countries.Get("IT")
+	assert.Nil(t, it.ValidatePostalCode("35018"))
+
+	err := it.ValidatePostalCode("x35018y")
+	assert.True(t, errors.Is(err, countries.ErrInvalidPostalCode))
+	assert.Contains(t, err.Error(), `"x35018y"`)
+	assert.Contains(t, err.Error(), "IT")
+
+	err = countries.Get("JM").ValidatePostalCode("35018")
+	assert.True(t, errors.Is(err, countries.ErrNoPostalCode))
+}
+
+func TestNormalizePostalCode(t *testing.T) {
+	for _, test := range []struct {
+		alpha2, input, expected string
+	}{
+		{"GB", "sw1a1aa", "SW1A 1AA"},
+		{"GB", "SW1A 1AA", "SW1A 1AA"},
+		{"GB", " sw1a-1aa ", "SW1A 1AA"},
+		{"GB", "w1a1aa", "W1A 1AA"},
+		{"NL", "1234ab", "1234 AB"},
+		{"NL", "1234 AB", "1234 AB"},
+		{"BR", "01001000", "01001-000"},
+		{"BR", "01001-000", "01001-000"},
+		{"CA", "k1a0b1", "K1A 0B1"},
+		{"JP", "1000001", "100-0001"},
+		{"US", "12345", "12345"},
+		{"US", "12345 6789", "12345-6789"},
+		{"IT", " 35018 ", "35018"},
+		{"PL", "00 950", "00-950"},
+	} {
+		c := countries.Get(test.alpha2)
+		postalCode, err := c.NormalizePostalCode(test.input)
+		assert.Nil(t, err, test.input)
+		assert.Equal(t, test.expected, postalCode, test.input)
+		assert.True(t, c.MatchPostalCode(postalCode), test.input)
+	}
+
+	_, err := countries.Get("IT").NormalizePostalCode("3501F")
+	assert.True(t, errors.Is(err, countries.ErrInvalidPostalCode))
+	_, err = countries.Get("JM").NormalizePostalCode("35018")
+	assert.True(t, errors.Is(err, countries.ErrNoPostalCode))
+}
+
+func BenchmarkMatchPostalCode(b *testing.B) {
+	postalCodes := []string{"35018", "SW1A 1AA", "10115", "75008", "x35018y"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := range countries.All {
+			countries.All[j].MatchPostalCode(postalCodes[j%len(postalCodes)])
+		}
+	}
+}
+
 func TestFormatAddress(t *testing.T) {
 	it := countries.Get("IT")
 	address := it.FormatAddress("Enrico Pilotto", "via Garibaldi 15", "97011", "Acate", "Ragusa")
@@ -390,6 +547,8 @@ func TestFormatAddress(t *testing.T) {
 	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n97011 Acate\nAndalucía\nSpain", address)
 	address = es.FormatAddress("Enrico Pilotto", "via Garibaldi 15", "97011", "Acate", "AN")
 	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n97011 Acate\nAndalucía\nSpain", address)
+	address = es.FormatAddress("Enrico Pilotto", "via Garibaldi 15", "97011", "Acate", "Andalusia")
+	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n97011 Acate\nAndalucía\nSpain", address)
 	address = es.FormatAddress("Enrico Pilotto", "via Garibaldi 15", "97011", "Acate", "xx")
 	assert.Equal(t, "Enrico Pilotto\nvia Garibaldi 15\n97011 Acate\nxx\nSpain", address)
 
@@ -0,0 +1,65 @@
+package countries
+
+import (
+	"fmt"
+
+	"github.com/pioz/countries/currency"
+)
+
+// Currency returns the ISO 4217 currency of the country, identified by
+// country.CurrencyCode. If the country has no currency returns nil.
+func (c *Country) Currency() *currency.Currency {
+	return currency.Get(c.CurrencyCode)
+}
+
+// Currencies returns the currency of the country followed by the alternative
+// currency, identified by country.AltCurrency, if any.
+func (c *Country) Currencies() []*currency.Currency {
+	result := make([]*currency.Currency, 0, 2)
+	for _, code := range []string{c.CurrencyCode, c.AltCurrency} {
+		if cur := currency.Get(code); cur != nil {
+			result = append(result, cur)
+		}
+	}
+	return result
+}
+
+// MoneyFormat returns the money format of the country: the format of the
+// first official language that has one, for the country or for the language,
+// or the English format.
+func (c *Country) MoneyFormat() currency.MoneyFormat {
+	for _, language := range c.LanguagesOfficial {
+		if f, found := currency.MoneyFormatFor(language + "_" + c.Alpha2); found {
+			return f
+		}
+	}
+	f, _ := currency.MoneyFormatFor("en")
+	return f
+}
+
+// FormatMoney returns the amount, in minor units of the currency, formatted
+// with the conventions of the country, like "1.234,56 €" in Italy. The narrow
+// symbol of the currency is used if the country uses it, like "$" for
+// Canadian dollars in Canada, otherwise the symbol, like "CA$". It returns an
+// error wrapping currency.ErrUnknownCurrency if the currency is nil.
+func (c *Country) FormatMoney(amount int64, cur *currency.Currency) (string, error) {
+	if cur == nil {
+		return "", fmt.Errorf("%w: nil currency", currency.ErrUnknownCurrency)
+	}
+	symbol := cur.Symbol
+	if cur.Code == c.CurrencyCode || cur.Code == c.AltCurrency {
+		symbol = cur.NarrowSymbol
+	}
+	return c.MoneyFormat().Format(amount, cur.MinorUnits, symbol), nil
+}
+
+// ConvertMoney converts the amount, in minor units of the country currency,
+// to minor units of the currency of the country to, with the rates of the
+// provider. See currency.Converter. It returns an error wrapping
+// currency.ErrUnknownCurrency if to is nil.
+func (c *Country) ConvertMoney(amount int64, to *Country, p currency.RateProvider) (int64, error) {
+	if to == nil {
+		return 0, fmt.Errorf("%w: nil country", currency.ErrUnknownCurrency)
+	}
+	return currency.NewConverter(p).Convert(amount, c.CurrencyCode, to.CurrencyCode)
+}
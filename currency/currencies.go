@@ -0,0 +1,679 @@
+// Code generated by "go run generator/main.go data"; DO NOT EDIT.
+
+package currency
+
+// All is a slice with all currencies ordered by code.
+var All = []Currency{
+	{Code: "AED", Numeric: "784", MinorUnits: 2, Name: "UAE Dirham", Translations: map[string]string{"en": "UAE Dirham"}, Symbol: "AED", NarrowSymbol: "د.إ"},
+	{Code: "AFN", Numeric: "971", MinorUnits: 2, Name: "Afghani", Translations: map[string]string{"en": "Afghani"}, Symbol: "AFN", NarrowSymbol: "؋"},
+	{Code: "ALL", Numeric: "008", MinorUnits: 2, Name: "Lek", Translations: map[string]string{"en": "Lek"}, Symbol: "ALL", NarrowSymbol: "L"},
+	{Code: "AMD", Numeric: "051", MinorUnits: 2, Name: "Armenian Dram", Translations: map[string]string{"en": "Armenian Dram"}, Symbol: "AMD", NarrowSymbol: "֏"},
+	{Code: "AOA", Numeric: "973", MinorUnits: 2, Name: "Kwanza", Translations: map[string]string{"en": "Kwanza"}, Symbol: "AOA", NarrowSymbol: "Kz"},
+	{Code: "ARS", Numeric: "032", MinorUnits: 2, Name: "Argentine Peso", Translations: map[string]string{"en": "Argentine Peso"}, Symbol: "ARS", NarrowSymbol: "$"},
+	{Code: "AUD", Numeric: "036", MinorUnits: 2, Name: "Australian Dollar", Translations: map[string]string{"de": "Australischer Dollar", "en": "Australian Dollar", "es": "dólar australiano", "fr": "dollar australien", "it": "dollaro australiano"}, Symbol: "A$", NarrowSymbol: "$"},
+	{Code: "AWG", Numeric: "533", MinorUnits: 2, Name: "Aruban Florin", Translations: map[string]string{"en": "Aruban Florin"}, Symbol: "AWG", NarrowSymbol: "ƒ"},
+	{Code: "AZN", Numeric: "944", MinorUnits: 2, Name: "Azerbaijan Manat", Translations: map[string]string{"en": "Azerbaijan Manat"}, Symbol: "AZN", NarrowSymbol: "₼"},
+	{Code: "BAM", Numeric: "977", MinorUnits: 2, Name: "Convertible Mark", Translations: map[string]string{"en": "Convertible Mark"}, Symbol: "BAM", NarrowSymbol: "KM"},
+	{Code: "BBD", Numeric: "052", MinorUnits: 2, Name: "Barbados Dollar", Translations: map[string]string{"en": "Barbados Dollar"}, Symbol: "BBD", NarrowSymbol: "$"},
+	{Code: "BDT", Numeric: "050", MinorUnits: 2, Name: "Taka", Translations: map[string]string{"en": "Taka"}, Symbol: "BDT", NarrowSymbol: "৳"},
+	{Code: "BGN", Numeric: "975", MinorUnits: 2, Name: "Bulgarian Lev", Translations: map[string]string{"en": "Bulgarian Lev"}, Symbol: "BGN", NarrowSymbol: "лв."},
+	{Code: "BHD", Numeric: "048", MinorUnits: 3, Name: "Bahraini Dinar", Translations: map[string]string{"en": "Bahraini Dinar"}, Symbol: "BHD", NarrowSymbol: "د.ب"},
+	{Code: "BIF", Numeric: "108", MinorUnits: 0, Name: "Burundi Franc", Translations: map[string]string{"en": "Burundi Franc"}, Symbol: "BIF", NarrowSymbol: "FBu"},
+	{Code: "BMD", Numeric: "060", MinorUnits: 2, Name: "Bermudian Dollar", Translations: map[string]string{"en": "Bermudian Dollar"}, Symbol: "BMD", NarrowSymbol: "$"},
+	{Code: "BND", Numeric: "096", MinorUnits: 2, Name: "Brunei Dollar", Translations: map[string]string{"en": "Brunei Dollar"}, Symbol: "BND", NarrowSymbol: "$"},
+	{Code: "BOB", Numeric: "068", MinorUnits: 2, Name: "Boliviano", Translations: map[string]string{"en": "Boliviano"}, Symbol: "BOB", NarrowSymbol: "Bs"},
+	{Code: "BRL", Numeric: "986", MinorUnits: 2, Name: "Brazilian Real", Translations: map[string]string{"de": "Brasilianischer Real", "en": "Brazilian Real", "es": "real brasileño", "fr": "réal brésilien", "it": "real brasiliano"}, Symbol: "R$", NarrowSymbol: "R$"},
+	{Code: "BSD", Numeric: "044", MinorUnits: 2, Name: "Bahamian Dollar", Translations: map[string]string{"en": "Bahamian Dollar"}, Symbol: "BSD", NarrowSymbol: "$"},
+	{Code: "BTN", Numeric: "064", MinorUnits: 2, Name: "Ngultrum", Translations: map[string]string{"en": "Ngultrum"}, Symbol: "BTN", NarrowSymbol: "Nu."},
+	{Code: "BWP", Numeric: "072", MinorUnits: 2, Name: "Pula", Translations: map[string]string{"en": "Pula"}, Symbol: "BWP", NarrowSymbol: "P"},
+	{Code: "BYN", Numeric: "933", MinorUnits: 2, Name: "Belarusian Ruble", Translations: map[string]string{"en": "Belarusian Ruble"}, Symbol: "BYN", NarrowSymbol: "Br"},
+	{Code: "BZD", Numeric: "084", MinorUnits: 2, Name: "Belize Dollar", Translations: map[string]string{"en": "Belize Dollar"}, Symbol: "BZD", NarrowSymbol: "$"},
+	{Code: "CAD", Numeric: "124", MinorUnits: 2, Name: "Canadian Dollar", Translations: map[string]string{"de": "Kanadischer Dollar", "en": "Canadian Dollar", "es": "dólar canadiense", "fr": "dollar canadien", "it": "dollaro canadese"}, Symbol: "CA$", NarrowSymbol: "$"},
+	{Code: "CDF", Numeric: "976", MinorUnits: 2, Name: "Congolese Franc", Translations: map[string]string{"en": "Congolese Franc"}, Symbol: "CDF", NarrowSymbol: "FC"},
+	{Code: "CHF", Numeric: "756", MinorUnits: 2, Name: "Swiss Franc", Translations: map[string]string{"de": "Schweizer Franken", "en": "Swiss Franc", "es": "franco suizo", "fr": "franc suisse", "it": "franco svizzero"}, Symbol: "CHF", NarrowSymbol: "CHF"},
+	{Code: "CLP", Numeric: "152", MinorUnits: 0, Name: "Chilean Peso", Translations: map[string]string{"en": "Chilean Peso"}, Symbol: "CLP", NarrowSymbol: "$"},
+	{Code: "CNY", Numeric: "156", MinorUnits: 2, Name: "Yuan Renminbi", Translations: map[string]string{"de": "Renminbi Yuan", "en": "Yuan Renminbi", "es": "yuan", "fr": "yuan renminbi chinois", "it": "renminbi cinese"}, Symbol: "CN¥", NarrowSymbol: "¥"},
+	{Code: "COP", Numeric: "170", MinorUnits: 2, Name: "Colombian Peso", Translations: map[string]string{"en": "Colombian Peso"}, Symbol: "COP", NarrowSymbol: "$"},
+	{Code: "CRC", Numeric: "188", MinorUnits: 2, Name: "Costa Rican Colon", Translations: map[string]string{"en": "Costa Rican Colon"}, Symbol: "CRC", NarrowSymbol: "₡"},
+	{Code: "CUP", Numeric: "192", MinorUnits: 2, Name: "Cuban Peso", Translations: map[string]string{"en": "Cuban Peso"}, Symbol: "CUP", NarrowSymbol: "$"},
+	{Code: "CVE", Numeric: "132", MinorUnits: 2, Name: "Cabo Verde Escudo", Translations: map[string]string{"en": "Cabo Verde Escudo"}, Symbol: "CVE", NarrowSymbol: "$"},
+	{Code: "CZK", Numeric: "203", MinorUnits: 2, Name: "Czech Koruna", Translations: map[string]string{"de": "Tschechische Krone", "en": "Czech Koruna", "es": "corona checa", "fr": "couronne tchèque", "it": "corona ceca"}, Symbol: "CZK", NarrowSymbol: "Kč"},
+	{Code: "DJF", Numeric: "262", MinorUnits: 0, Name: "Djibouti Franc", Translations: map[string]string{"en": "Djibouti Franc"}, Symbol: "DJF", NarrowSymbol: "Fdj"},
+	{Code: "DKK", Numeric: "208", MinorUnits: 2, Name: "Danish Krone", Translations: map[string]string{"de": "Dänische Krone", "en": "Danish Krone", "es": "corona danesa", "fr": "couronne danoise", "it": "corona danese"}, Symbol: "DKK", NarrowSymbol: "kr"},
+	{Code: "DOP", Numeric: "214", MinorUnits: 2, Name: "Dominican Peso", Translations: map[string]string{"en": "Dominican Peso"}, Symbol: "DOP", NarrowSymbol: "$"},
+	{Code: "DZD", Numeric: "012", MinorUnits: 2, Name: "Algerian Dinar", Translations: map[string]string{"en": "Algerian Dinar"}, Symbol: "DZD", NarrowSymbol: "د.ج"},
+	{Code: "EGP", Numeric: "818", MinorUnits: 2, Name: "Egyptian Pound", Translations: map[string]string{"en": "Egyptian Pound"}, Symbol: "EGP", NarrowSymbol: "E£"},
+	{Code: "ERN", Numeric: "232", MinorUnits: 2, Name: "Nakfa", Translations: map[string]string{"en": "Nakfa"}, Symbol: "ERN", NarrowSymbol: "Nfk"},
+	{Code: "ETB", Numeric: "230", MinorUnits: 2, Name: "Ethiopian Birr", Translations: map[string]string{"en": "Ethiopian Birr"}, Symbol: "ETB", NarrowSymbol: "Br"},
+	{Code: "EUR", Numeric: "978", MinorUnits: 2, Name: "Euro", Translations: map[string]string{"de": "Euro", "en": "Euro", "es": "euro", "fr": "euro", "it": "euro"}, Symbol: "€", NarrowSymbol: "€"},
+	{Code: "FJD", Numeric: "242", MinorUnits: 2, Name: "Fiji Dollar", Translations: map[string]string{"en": "Fiji Dollar"}, Symbol: "FJD", NarrowSymbol: "$"},
+	{Code: "FKP", Numeric: "238", MinorUnits: 2, Name: "Falkland Islands Pound", Translations: map[string]string{"en": "Falkland Islands Pound"}, Symbol: "FKP", NarrowSymbol: "£"},
+	{Code: "GBP", Numeric: "826", MinorUnits: 2, Name: "Pound Sterling", Translations: map[string]string{"de": "Britisches Pfund", "en": "Pound Sterling", "es": "libra esterlina", "fr": "livre sterling", "it": "sterlina britannica"}, Symbol: "£", NarrowSymbol: "£"},
+	{Code: "GEL", Numeric: "981", MinorUnits: 2, Name: "Lari", Translations: map[string]string{"en": "Lari"}, Symbol: "GEL", NarrowSymbol: "₾"},
+	{Code: "GHS", Numeric: "936", MinorUnits: 2, Name: "Ghana Cedi", Translations: map[string]string{"en": "Ghana Cedi"}, Symbol: "GHS", NarrowSymbol: "GH₵"},
+	{Code: "GIP", Numeric: "292", MinorUnits: 2, Name: "Gibraltar Pound", Translations: map[string]string{"en": "Gibraltar Pound"}, Symbol: "GIP", NarrowSymbol: "£"},
+	{Code: "GMD", Numeric: "270", MinorUnits: 2, Name: "Dalasi", Translations: map[string]string{"en": "Dalasi"}, Symbol: "GMD", NarrowSymbol: "D"},
+	{Code: "GNF", Numeric: "324", MinorUnits: 0, Name: "Guinean Franc", Translations: map[string]string{"en": "Guinean Franc"}, Symbol: "GNF", NarrowSymbol: "FG"},
+	{Code: "GTQ", Numeric: "320", MinorUnits: 2, Name: "Quetzal", Translations: map[string]string{"en": "Quetzal"}, Symbol: "GTQ", NarrowSymbol: "Q"},
+	{Code: "GYD", Numeric: "328", MinorUnits: 2, Name: "Guyana Dollar", Translations: map[string]string{"en": "Guyana Dollar"}, Symbol: "GYD", NarrowSymbol: "$"},
+	{Code: "HKD", Numeric: "344", MinorUnits: 2, Name: "Hong Kong Dollar", Translations: map[string]string{"en": "Hong Kong Dollar"}, Symbol: "HK$", NarrowSymbol: "$"},
+	{Code: "HNL", Numeric: "340", MinorUnits: 2, Name: "Lempira", Translations: map[string]string{"en": "Lempira"}, Symbol: "HNL", NarrowSymbol: "L"},
+	{Code: "HTG", Numeric: "332", MinorUnits: 2, Name: "Gourde", Translations: map[string]string{"en": "Gourde"}, Symbol: "HTG", NarrowSymbol: "G"},
+	{Code: "HUF", Numeric: "348", MinorUnits: 2, Name: "Forint", Translations: map[string]string{"de": "Ungarischer Forint", "en": "Forint", "es": "forinto húngaro", "fr": "forint hongrois", "it": "fiorino ungherese"}, Symbol: "HUF", NarrowSymbol: "Ft"},
+	{Code: "IDR", Numeric: "360", MinorUnits: 2, Name: "Rupiah", Translations: map[string]string{"en": "Rupiah"}, Symbol: "IDR", NarrowSymbol: "Rp"},
+	{Code: "ILS", Numeric: "376", MinorUnits: 2, Name: "New Israeli Sheqel", Translations: map[string]string{"en": "New Israeli Sheqel"}, Symbol: "₪", NarrowSymbol: "₪"},
+	{Code: "INR", Numeric: "356", MinorUnits: 2, Name: "Indian Rupee", Translations: map[string]string{"de": "Indische Rupie", "en": "Indian Rupee", "es": "rupia india", "fr": "roupie indienne", "it": "rupia indiana"}, Symbol: "₹", NarrowSymbol: "₹"},
+	{Code: "IQD", Numeric: "368", MinorUnits: 3, Name: "Iraqi Dinar", Translations: map[string]string{"en": "Iraqi Dinar"}, Symbol: "IQD", NarrowSymbol: "ع.د"},
+	{Code: "IRR", Numeric: "364", MinorUnits: 2, Name: "Iranian Rial", Translations: map[string]string{"en": "Iranian Rial"}, Symbol: "IRR", NarrowSymbol: "﷼"},
+	{Code: "ISK", Numeric: "352", MinorUnits: 0, Name: "Iceland Krona", Translations: map[string]string{"en": "Iceland Krona"}, Symbol: "ISK", NarrowSymbol: "kr"},
+	{Code: "JMD", Numeric: "388", MinorUnits: 2, Name: "Jamaican Dollar", Translations: map[string]string{"en": "Jamaican Dollar"}, Symbol: "JMD", NarrowSymbol: "$"},
+	{Code: "JOD", Numeric: "400", MinorUnits: 3, Name: "Jordanian Dinar", Translations: map[string]string{"en": "Jordanian Dinar"}, Symbol: "JOD", NarrowSymbol: "د.ا"},
+	{Code: "JPY", Numeric: "392", MinorUnits: 0, Name: "Yen", Translations: map[string]string{"de": "Japanischer Yen", "en": "Yen", "es": "yen", "fr": "yen japonais", "it": "yen giapponese"}, Symbol: "¥", NarrowSymbol: "¥"},
+	{Code: "KES", Numeric: "404", MinorUnits: 2, Name: "Kenyan Shilling", Translations: map[string]string{"en": "Kenyan Shilling"}, Symbol: "KES", NarrowSymbol: "KSh"},
+	{Code: "KGS", Numeric: "417", MinorUnits: 2, Name: "Som", Translations: map[string]string{"en": "Som"}, Symbol: "KGS", NarrowSymbol: "KGS"},
+	{Code: "KHR", Numeric: "116", MinorUnits: 2, Name: "Riel", Translations: map[string]string{"en": "Riel"}, Symbol: "KHR", NarrowSymbol: "៛"},
+	{Code: "KMF", Numeric: "174", MinorUnits: 0, Name: "Comorian Franc", Translations: map[string]string{"en": "Comorian Franc"}, Symbol: "KMF", NarrowSymbol: "CF"},
+	{Code: "KPW", Numeric: "408", MinorUnits: 2, Name: "North Korean Won", Translations: map[string]string{"en": "North Korean Won"}, Symbol: "KPW", NarrowSymbol: "₩"},
+	{Code: "KRW", Numeric: "410", MinorUnits: 0, Name: "Won", Translations: map[string]string{"en": "Won"}, Symbol: "₩", NarrowSymbol: "₩"},
+	{Code: "KWD", Numeric: "414", MinorUnits: 3, Name: "Kuwaiti Dinar", Translations: map[string]string{"en": "Kuwaiti Dinar"}, Symbol: "KWD", NarrowSymbol: "د.ك"},
+	{Code: "KYD", Numeric: "136", MinorUnits: 2, Name: "Cayman Islands Dollar", Translations: map[string]string{"en": "Cayman Islands Dollar"}, Symbol: "KYD", NarrowSymbol: "$"},
+	{Code: "KZT", Numeric: "398", MinorUnits: 2, Name: "Tenge", Translations: map[string]string{"en": "Tenge"}, Symbol: "KZT", NarrowSymbol: "₸"},
+	{Code: "LAK", Numeric: "418", MinorUnits: 2, Name: "Lao Kip", Translations: map[string]string{"en": "Lao Kip"}, Symbol: "LAK", NarrowSymbol: "₭"},
+	{Code: "LBP", Numeric: "422", MinorUnits: 2, Name: "Lebanese Pound", Translations: map[string]string{"en": "Lebanese Pound"}, Symbol: "LBP", NarrowSymbol: "ل.ل"},
+	{Code: "LKR", Numeric: "144", MinorUnits: 2, Name: "Sri Lanka Rupee", Translations: map[string]string{"en": "Sri Lanka Rupee"}, Symbol: "LKR", NarrowSymbol: "Rs"},
+	{Code: "LRD", Numeric: "430", MinorUnits: 2, Name: "Liberian Dollar", Translations: map[string]string{"en": "Liberian Dollar"}, Symbol: "LRD", NarrowSymbol: "$"},
+	{Code: "LSL", Numeric: "426", MinorUnits: 2, Name: "Loti", Translations: map[string]string{"en": "Loti"}, Symbol: "LSL", NarrowSymbol: "L"},
+	{Code: "LYD", Numeric: "434", MinorUnits: 3, Name: "Libyan Dinar", Translations: map[string]string{"en": "Libyan Dinar"}, Symbol: "LYD", NarrowSymbol: "ل.د"},
+	{Code: "MAD", Numeric: "504", MinorUnits: 2, Name: "Moroccan Dirham", Translations: map[string]string{"en": "Moroccan Dirham"}, Symbol: "MAD", NarrowSymbol: "د.م."},
+	{Code: "MDL", Numeric: "498", MinorUnits: 2, Name: "Moldovan Leu", Translations: map[string]string{"en": "Moldovan Leu"}, Symbol: "MDL", NarrowSymbol: "L"},
+	{Code: "MGA", Numeric: "969", MinorUnits: 2, Name: "Malagasy Ariary", Translations: map[string]string{"en": "Malagasy Ariary"}, Symbol: "MGA", NarrowSymbol: "Ar"},
+	{Code: "MKD", Numeric: "807", MinorUnits: 2, Name: "Denar", Translations: map[string]string{"en": "Denar"}, Symbol: "MKD", NarrowSymbol: "ден"},
+	{Code: "MMK", Numeric: "104", MinorUnits: 2, Name: "Kyat", Translations: map[string]string{"en": "Kyat"}, Symbol: "MMK", NarrowSymbol: "K"},
+	{Code: "MNT", Numeric: "496", MinorUnits: 2, Name: "Tugrik", Translations: map[string]string{"en": "Tugrik"}, Symbol: "MNT", NarrowSymbol: "₮"},
+	{Code: "MOP", Numeric: "446", MinorUnits: 2, Name: "Pataca", Translations: map[string]string{"en": "Pataca"}, Symbol: "MOP", NarrowSymbol: "MOP$"},
+	{Code: "MRU", Numeric: "929", MinorUnits: 2, Name: "Ouguiya", Translations: map[string]string{"en": "Ouguiya"}, Symbol: "MRU", NarrowSymbol: "UM"},
+	{Code: "MUR", Numeric: "480", MinorUnits: 2, Name: "Mauritius Rupee", Translations: map[string]string{"en": "Mauritius Rupee"}, Symbol: "MUR", NarrowSymbol: "Rs"},
+	{Code: "MVR", Numeric: "462", MinorUnits: 2, Name: "Rufiyaa", Translations: map[string]string{"en": "Rufiyaa"}, Symbol: "MVR", NarrowSymbol: "Rf"},
+	{Code: "MWK", Numeric: "454", MinorUnits: 2, Name: "Malawi Kwacha", Translations: map[string]string{"en": "Malawi Kwacha"}, Symbol: "MWK", NarrowSymbol: "MK"},
+	{Code: "MXN", Numeric: "484", MinorUnits: 2, Name: "Mexican Peso", Translations: map[string]string{"de": "Mexikanischer Peso", "en": "Mexican Peso", "es": "peso mexicano", "fr": "peso mexicain", "it": "peso messicano"}, Symbol: "MX$", NarrowSymbol: "$"},
+	{Code: "MYR", Numeric: "458", MinorUnits: 2, Name: "Malaysian Ringgit", Translations: map[string]string{"en": "Malaysian Ringgit"}, Symbol: "MYR", NarrowSymbol: "RM"},
+	{Code: "MZN", Numeric: "943", MinorUnits: 2, Name: "Mozambique Metical", Translations: map[string]string{"en": "Mozambique Metical"}, Symbol: "MZN", NarrowSymbol: "MT"},
+	{Code: "NAD", Numeric: "516", MinorUnits: 2, Name: "Namibia Dollar", Translations: map[string]string{"en": "Namibia Dollar"}, Symbol: "NAD", NarrowSymbol: "$"},
+	{Code: "NGN", Numeric: "566", MinorUnits: 2, Name: "Naira", Translations: map[string]string{"en": "Naira"}, Symbol: "NGN", NarrowSymbol: "₦"},
+	{Code: "NIO", Numeric: "558", MinorUnits: 2, Name: "Cordoba Oro", Translations: map[string]string{"en": "Cordoba Oro"}, Symbol: "NIO", NarrowSymbol: "C$"},
+	{Code: "NOK", Numeric: "578", MinorUnits: 2, Name: "Norwegian Krone", Translations: map[string]string{"de": "Norwegische Krone", "en": "Norwegian Krone", "es": "corona noruega", "fr": "couronne norvégienne", "it": "corona norvegese"}, Symbol: "NOK", NarrowSymbol: "kr"},
+	{Code: "NPR", Numeric: "524", MinorUnits: 2, Name: "Nepalese Rupee", Translations: map[string]string{"en": "Nepalese Rupee"}, Symbol: "NPR", NarrowSymbol: "Rs"},
+	{Code: "NZD", Numeric: "554", MinorUnits: 2, Name: "New Zealand Dollar", Translations: map[string]string{"en": "New Zealand Dollar"}, Symbol: "NZ$", NarrowSymbol: "$"},
+	{Code: "OMR", Numeric: "512", MinorUnits: 3, Name: "Rial Omani", Translations: map[string]string{"en": "Rial Omani"}, Symbol: "OMR", NarrowSymbol: "ر.ع."},
+	{Code: "PAB", Numeric: "590", MinorUnits: 2, Name: "Balboa", Translations: map[string]string{"en": "Balboa"}, Symbol: "PAB", NarrowSymbol: "B/."},
+	{Code: "PEN", Numeric: "604", MinorUnits: 2, Name: "Sol", Translations: map[string]string{"en": "Sol"}, Symbol: "PEN", NarrowSymbol: "S/"},
+	{Code: "PGK", Numeric: "598", MinorUnits: 2, Name: "Kina", Translations: map[string]string{"en": "Kina"}, Symbol: "PGK", NarrowSymbol: "K"},
+	{Code: "PHP", Numeric: "608", MinorUnits: 2, Name: "Philippine Peso", Translations: map[string]string{"en": "Philippine Peso"}, Symbol: "₱", NarrowSymbol: "₱"},
+	{Code: "PKR", Numeric: "586", MinorUnits: 2, Name: "Pakistan Rupee", Translations: map[string]string{"en": "Pakistan Rupee"}, Symbol: "PKR", NarrowSymbol: "Rs"},
+	{Code: "PLN", Numeric: "985", MinorUnits: 2, Name: "Zloty", Translations: map[string]string{"de": "Polnischer Złoty", "en": "Zloty", "es": "esloti", "fr": "zloty polonais", "it": "złoty polacco"}, Symbol: "PLN", NarrowSymbol: "zł"},
+	{Code: "PYG", Numeric: "600", MinorUnits: 0, Name: "Guarani", Translations: map[string]string{"en": "Guarani"}, Symbol: "PYG", NarrowSymbol: "₲"},
+	{Code: "QAR", Numeric: "634", MinorUnits: 2, Name: "Qatari Rial", Translations: map[string]string{"en": "Qatari Rial"}, Symbol: "QAR", NarrowSymbol: "ر.ق"},
+	{Code: "RON", Numeric: "946", MinorUnits: 2, Name: "Romanian Leu", Translations: map[string]string{"en": "Romanian Leu"}, Symbol: "RON", NarrowSymbol: "lei"},
+	{Code: "RSD", Numeric: "941", MinorUnits: 2, Name: "Serbian Dinar", Translations: map[string]string{"en": "Serbian Dinar"}, Symbol: "RSD", NarrowSymbol: "дин."},
+	{Code: "RUB", Numeric: "643", MinorUnits: 2, Name: "Russian Ruble", Translations: map[string]string{"de": "Russischer Rubel", "en": "Russian Ruble", "es": "rublo ruso", "fr": "rouble russe", "it": "rublo russo"}, Symbol: "RUB", NarrowSymbol: "₽"},
+	{Code: "RWF", Numeric: "646", MinorUnits: 0, Name: "Rwanda Franc", Translations: map[string]string{"en": "Rwanda Franc"}, Symbol: "RWF", NarrowSymbol: "RF"},
+	{Code: "SAR", Numeric: "682", MinorUnits: 2, Name: "Saudi Riyal", Translations: map[string]string{"en": "Saudi Riyal"}, Symbol: "SAR", NarrowSymbol: "ر.س"},
+	{Code: "SBD", Numeric: "090", MinorUnits: 2, Name: "Solomon Islands Dollar", Translations: map[string]string{"en": "Solomon Islands Dollar"}, Symbol: "SBD", NarrowSymbol: "$"},
+	{Code: "SCR", Numeric: "690", MinorUnits: 2, Name: "Seychelles Rupee", Translations: map[string]string{"en": "Seychelles Rupee"}, Symbol: "SCR", NarrowSymbol: "Rs"},
+	{Code: "SDG", Numeric: "938", MinorUnits: 2, Name: "Sudanese Pound", Translations: map[string]string{"en": "Sudanese Pound"}, Symbol: "SDG", NarrowSymbol: "ج.س."},
+	{Code: "SEK", Numeric: "752", MinorUnits: 2, Name: "Swedish Krona", Translations: map[string]string{"de": "Schwedische Krone", "en": "Swedish Krona", "es": "corona sueca", "fr": "couronne suédoise", "it": "corona svedese"}, Symbol: "SEK", NarrowSymbol: "kr"},
+	{Code: "SGD", Numeric: "702", MinorUnits: 2, Name: "Singapore Dollar", Translations: map[string]string{"en": "Singapore Dollar"}, Symbol: "SGD", NarrowSymbol: "$"},
+	{Code: "SHP", Numeric: "654", MinorUnits: 2, Name: "Saint Helena Pound", Translations: map[string]string{"en": "Saint Helena Pound"}, Symbol: "SHP", NarrowSymbol: "£"},
+	{Code: "SLE", Numeric: "925", MinorUnits: 2, Name: "Leone", Translations: map[string]string{"en": "Leone"}, Symbol: "SLE", NarrowSymbol: "Le"},
+	{Code: "SOS", Numeric: "706", MinorUnits: 2, Name: "Somali Shilling", Translations: map[string]string{"en": "Somali Shilling"}, Symbol: "SOS", NarrowSymbol: "Sh"},
+	{Code: "SRD", Numeric: "968", MinorUnits: 2, Name: "Surinam Dollar", Translations: map[string]string{"en": "Surinam Dollar"}, Symbol: "SRD", NarrowSymbol: "$"},
+	{Code: "SSP", Numeric: "728", MinorUnits: 2, Name: "South Sudanese Pound", Translations: map[string]string{"en": "South Sudanese Pound"}, Symbol: "SSP", NarrowSymbol: "£"},
+	{Code: "STN", Numeric: "930", MinorUnits: 2, Name: "Dobra", Translations: map[string]string{"en": "Dobra"}, Symbol: "STN", NarrowSymbol: "Db"},
+	{Code: "SYP", Numeric: "760", MinorUnits: 2, Name: "Syrian Pound", Translations: map[string]string{"en": "Syrian Pound"}, Symbol: "SYP", NarrowSymbol: "£"},
+	{Code: "SZL", Numeric: "748", MinorUnits: 2, Name: "Lilangeni", Translations: map[string]string{"en": "Lilangeni"}, Symbol: "SZL", NarrowSymbol: "E"},
+	{Code: "THB", Numeric: "764", MinorUnits: 2, Name: "Baht", Translations: map[string]string{"en": "Baht"}, Symbol: "THB", NarrowSymbol: "฿"},
+	{Code: "TJS", Numeric: "972", MinorUnits: 2, Name: "Somoni", Translations: map[string]string{"en": "Somoni"}, Symbol: "TJS", NarrowSymbol: "SM"},
+	{Code: "TMT", Numeric: "934", MinorUnits: 2, Name: "Turkmenistan New Manat", Translations: map[string]string{"en": "Turkmenistan New Manat"}, Symbol: "TMT", NarrowSymbol: "m"},
+	{Code: "TND", Numeric: "788", MinorUnits: 3, Name: "Tunisian Dinar", Translations: map[string]string{"en": "Tunisian Dinar"}, Symbol: "TND", NarrowSymbol: "د.ت"},
+	{Code: "TOP", Numeric: "776", MinorUnits: 2, Name: "Pa’anga", Translations: map[string]string{"en": "Pa’anga"}, Symbol: "TOP", NarrowSymbol: "T$"},
+	{Code: "TRY", Numeric: "949", MinorUnits: 2, Name: "Turkish Lira", Translations: map[string]string{"de": "Türkische Lira", "en": "Turkish Lira", "es": "lira turca", "fr": "livre turque", "it": "lira turca"}, Symbol: "TRY", NarrowSymbol: "₺"},
+	{Code: "TTD", Numeric: "780", MinorUnits: 2, Name: "Trinidad and Tobago Dollar", Translations: map[string]string{"en": "Trinidad and Tobago Dollar"}, Symbol: "TTD", NarrowSymbol: "$"},
+	{Code: "TWD", Numeric: "901", MinorUnits: 2, Name: "New Taiwan Dollar", Translations: map[string]string{"en": "New Taiwan Dollar"}, Symbol: "NT$", NarrowSymbol: "$"},
+	{Code: "TZS", Numeric: "834", MinorUnits: 2, Name: "Tanzanian Shilling", Translations: map[string]string{"en": "Tanzanian Shilling"}, Symbol: "TZS", NarrowSymbol: "TSh"},
+	{Code: "UAH", Numeric: "980", MinorUnits: 2, Name: "Hryvnia", Translations: map[string]string{"en": "Hryvnia"}, Symbol: "UAH", NarrowSymbol: "₴"},
+	{Code: "UGX", Numeric: "800", MinorUnits: 0, Name: "Uganda Shilling", Translations: map[string]string{"en": "Uganda Shilling"}, Symbol: "UGX", NarrowSymbol: "USh"},
+	{Code: "USD", Numeric: "840", MinorUnits: 2, Name: "US Dollar", Translations: map[string]string{"de": "US-Dollar", "en": "US Dollar", "es": "dólar estadounidense", "fr": "dollar des États-Unis", "it": "dollaro statunitense"}, Symbol: "$", NarrowSymbol: "$"},
+	{Code: "UYU", Numeric: "858", MinorUnits: 2, Name: "Peso Uruguayo", Translations: map[string]string{"en": "Peso Uruguayo"}, Symbol: "UYU", NarrowSymbol: "$"},
+	{Code: "UZS", Numeric: "860", MinorUnits: 2, Name: "Uzbekistan Sum", Translations: map[string]string{"en": "Uzbekistan Sum"}, Symbol: "UZS", NarrowSymbol: "soʻm"},
+	{Code: "VES", Numeric: "928", MinorUnits: 2, Name: "Bolívar Soberano", Translations: map[string]string{"en": "Bolívar Soberano"}, Symbol: "VES", NarrowSymbol: "Bs.S"},
+	{Code: "VND", Numeric: "704", MinorUnits: 0, Name: "Dong", Translations: map[string]string{"en": "Dong"}, Symbol: "₫", NarrowSymbol: "₫"},
+	{Code: "VUV", Numeric: "548", MinorUnits: 0, Name: "Vatu", Translations: map[string]string{"en": "Vatu"}, Symbol: "VUV", NarrowSymbol: "VT"},
+	{Code: "WST", Numeric: "882", MinorUnits: 2, Name: "Tala", Translations: map[string]string{"en": "Tala"}, Symbol: "WST", NarrowSymbol: "WS$"},
+	{Code: "XAF", Numeric: "950", MinorUnits: 0, Name: "CFA Franc BEAC", Translations: map[string]string{"en": "CFA Franc BEAC"}, Symbol: "FCFA", NarrowSymbol: "FCFA"},
+	{Code: "XCD", Numeric: "951", MinorUnits: 2, Name: "East Caribbean Dollar", Translations: map[string]string{"en": "East Caribbean Dollar"}, Symbol: "EC$", NarrowSymbol: "$"},
+	{Code: "XCG", Numeric: "532", MinorUnits: 2, Name: "Caribbean Guilder", Translations: map[string]string{"en": "Caribbean Guilder"}, Symbol: "XCG", NarrowSymbol: "Cg"},
+	{Code: "XOF", Numeric: "952", MinorUnits: 0, Name: "CFA Franc BCEAO", Translations: map[string]string{"en": "CFA Franc BCEAO"}, Symbol: "F CFA", NarrowSymbol: "F CFA"},
+	{Code: "XPF", Numeric: "953", MinorUnits: 0, Name: "CFP Franc", Translations: map[string]string{"en": "CFP Franc"}, Symbol: "CFPF", NarrowSymbol: "CFPF"},
+	{Code: "YER", Numeric: "886", MinorUnits: 2, Name: "Yemeni Rial", Translations: map[string]string{"en": "Yemeni Rial"}, Symbol: "YER", NarrowSymbol: "﷼"},
+	{Code: "ZAR", Numeric: "710", MinorUnits: 2, Name: "Rand", Translations: map[string]string{"de": "Südafrikanischer Rand", "en": "Rand", "es": "rand", "fr": "rand sud-africain", "it": "rand sudafricano"}, Symbol: "ZAR", NarrowSymbol: "R"},
+	{Code: "ZMW", Numeric: "967", MinorUnits: 2, Name: "Zambian Kwacha", Translations: map[string]string{"en": "Zambian Kwacha"}, Symbol: "ZMW", NarrowSymbol: "ZK"},
+}
+
+// byCode maps currency codes to their index in All.
+var byCode = map[string]int{
+	"AED": 0,
+	"AFN": 1,
+	"ALL": 2,
+	"AMD": 3,
+	"AOA": 4,
+	"ARS": 5,
+	"AUD": 6,
+	"AWG": 7,
+	"AZN": 8,
+	"BAM": 9,
+	"BBD": 10,
+	"BDT": 11,
+	"BGN": 12,
+	"BHD": 13,
+	"BIF": 14,
+	"BMD": 15,
+	"BND": 16,
+	"BOB": 17,
+	"BRL": 18,
+	"BSD": 19,
+	"BTN": 20,
+	"BWP": 21,
+	"BYN": 22,
+	"BZD": 23,
+	"CAD": 24,
+	"CDF": 25,
+	"CHF": 26,
+	"CLP": 27,
+	"CNY": 28,
+	"COP": 29,
+	"CRC": 30,
+	"CUP": 31,
+	"CVE": 32,
+	"CZK": 33,
+	"DJF": 34,
+	"DKK": 35,
+	"DOP": 36,
+	"DZD": 37,
+	"EGP": 38,
+	"ERN": 39,
+	"ETB": 40,
+	"EUR": 41,
+	"FJD": 42,
+	"FKP": 43,
+	"GBP": 44,
+	"GEL": 45,
+	"GHS": 46,
+	"GIP": 47,
+	"GMD": 48,
+	"GNF": 49,
+	"GTQ": 50,
+	"GYD": 51,
+	"HKD": 52,
+	"HNL": 53,
+	"HTG": 54,
+	"HUF": 55,
+	"IDR": 56,
+	"ILS": 57,
+	"INR": 58,
+	"IQD": 59,
+	"IRR": 60,
+	"ISK": 61,
+	"JMD": 62,
+	"JOD": 63,
+	"JPY": 64,
+	"KES": 65,
+	"KGS": 66,
+	"KHR": 67,
+	"KMF": 68,
+	"KPW": 69,
+	"KRW": 70,
+	"KWD": 71,
+	"KYD": 72,
+	"KZT": 73,
+	"LAK": 74,
+	"LBP": 75,
+	"LKR": 76,
+	"LRD": 77,
+	"LSL": 78,
+	"LYD": 79,
+	"MAD": 80,
+	"MDL": 81,
+	"MGA": 82,
+	"MKD": 83,
+	"MMK": 84,
+	"MNT": 85,
+	"MOP": 86,
+	"MRU": 87,
+	"MUR": 88,
+	"MVR": 89,
+	"MWK": 90,
+	"MXN": 91,
+	"MYR": 92,
+	"MZN": 93,
+	"NAD": 94,
+	"NGN": 95,
+	"NIO": 96,
+	"NOK": 97,
+	"NPR": 98,
+	"NZD": 99,
+	"OMR": 100,
+	"PAB": 101,
+	"PEN": 102,
+	"PGK": 103,
+	"PHP": 104,
+	"PKR": 105,
+	"PLN": 106,
+	"PYG": 107,
+	"QAR": 108,
+	"RON": 109,
+	"RSD": 110,
+	"RUB": 111,
+	"RWF": 112,
+	"SAR": 113,
+	"SBD": 114,
+	"SCR": 115,
+	"SDG": 116,
+	"SEK": 117,
+	"SGD": 118,
+	"SHP": 119,
+	"SLE": 120,
+	"SOS": 121,
+	"SRD": 122,
+	"SSP": 123,
+	"STN": 124,
+	"SYP": 125,
+	"SZL": 126,
+	"THB": 127,
+	"TJS": 128,
+	"TMT": 129,
+	"TND": 130,
+	"TOP": 131,
+	"TRY": 132,
+	"TTD": 133,
+	"TWD": 134,
+	"TZS": 135,
+	"UAH": 136,
+	"UGX": 137,
+	"USD": 138,
+	"UYU": 139,
+	"UZS": 140,
+	"VES": 141,
+	"VND": 142,
+	"VUV": 143,
+	"WST": 144,
+	"XAF": 145,
+	"XCD": 146,
+	"XCG": 147,
+	"XOF": 148,
+	"XPF": 149,
+	"YER": 150,
+	"ZAR": 151,
+	"ZMW": 152,
+}
+
+// byNumeric maps currency numeric codes to their index in All.
+var byNumeric = map[string]int{
+	"784": 0,
+	"971": 1,
+	"008": 2,
+	"051": 3,
+	"973": 4,
+	"032": 5,
+	"036": 6,
+	"533": 7,
+	"944": 8,
+	"977": 9,
+	"052": 10,
+	"050": 11,
+	"975": 12,
+	"048": 13,
+	"108": 14,
+	"060": 15,
+	"096": 16,
+	"068": 17,
+	"986": 18,
+	"044": 19,
+	"064": 20,
+	"072": 21,
+	"933": 22,
+	"084": 23,
+	"124": 24,
+	"976": 25,
+	"756": 26,
+	"152": 27,
+	"156": 28,
+	"170": 29,
+	"188": 30,
+	"192": 31,
+	"132": 32,
+	"203": 33,
+	"262": 34,
+	"208": 35,
+	"214": 36,
+	"012": 37,
+	"818": 38,
+	"232": 39,
+	"230": 40,
+	"978": 41,
+	"242": 42,
+	"238": 43,
+	"826": 44,
+	"981": 45,
+	"936": 46,
+	"292": 47,
+	"270": 48,
+	"324": 49,
+	"320": 50,
+	"328": 51,
+	"344": 52,
+	"340": 53,
+	"332": 54,
+	"348": 55,
+	"360": 56,
+	"376": 57,
+	"356": 58,
+	"368": 59,
+	"364": 60,
+	"352": 61,
+	"388": 62,
+	"400": 63,
+	"392": 64,
+	"404": 65,
+	"417": 66,
+	"116": 67,
+	"174": 68,
+	"408": 69,
+	"410": 70,
+	"414": 71,
+	"136": 72,
+	"398": 73,
+	"418": 74,
+	"422": 75,
+	"144": 76,
+	"430": 77,
+	"426": 78,
+	"434": 79,
+	"504": 80,
+	"498": 81,
+	"969": 82,
+	"807": 83,
+	"104": 84,
+	"496": 85,
+	"446": 86,
+	"929": 87,
+	"480": 88,
+	"462": 89,
+	"454": 90,
+	"484": 91,
+	"458": 92,
+	"943": 93,
+	"516": 94,
+	"566": 95,
+	"558": 96,
+	"578": 97,
+	"524": 98,
+	"554": 99,
+	"512": 100,
+	"590": 101,
+	"604": 102,
+	"598": 103,
+	"608": 104,
+	"586": 105,
+	"985": 106,
+	"600": 107,
+	"634": 108,
+	"946": 109,
+	"941": 110,
+	"643": 111,
+	"646": 112,
+	"682": 113,
+	"090": 114,
+	"690": 115,
+	"938": 116,
+	"752": 117,
+	"702": 118,
+	"654": 119,
+	"925": 120,
+	"706": 121,
+	"968": 122,
+	"728": 123,
+	"930": 124,
+	"760": 125,
+	"748": 126,
+	"764": 127,
+	"972": 128,
+	"934": 129,
+	"788": 130,
+	"776": 131,
+	"949": 132,
+	"780": 133,
+	"901": 134,
+	"834": 135,
+	"980": 136,
+	"800": 137,
+	"840": 138,
+	"858": 139,
+	"860": 140,
+	"928": 141,
+	"704": 142,
+	"548": 143,
+	"882": 144,
+	"950": 145,
+	"951": 146,
+	"532": 147,
+	"952": 148,
+	"953": 149,
+	"886": 150,
+	"710": 151,
+	"967": 152,
+}
+
+// countriesByCode maps currency codes to the alpha2 codes of the countries
+// using them.
+var countriesByCode = map[string][]string{
+	"AED": []string{"AE"},
+	"AFN": []string{"AF"},
+	"ALL": []string{"AL"},
+	"AMD": []string{"AM"},
+	"AOA": []string{"AO"},
+	"ARS": []string{"AR"},
+	"AUD": []string{"AU", "CC", "CX", "HM", "KI", "NF", "NR", "TV"},
+	"AWG": []string{"AW"},
+	"AZN": []string{"AZ"},
+	"BAM": []string{"BA"},
+	"BBD": []string{"BB"},
+	"BDT": []string{"BD"},
+	"BGN": []string{"BG"},
+	"BHD": []string{"BH"},
+	"BIF": []string{"BI"},
+	"BMD": []string{"BM"},
+	"BND": []string{"BN"},
+	"BOB": []string{"BO"},
+	"BRL": []string{"BR"},
+	"BSD": []string{"BS"},
+	"BTN": []string{"BT"},
+	"BWP": []string{"BW"},
+	"BYN": []string{"BY"},
+	"BZD": []string{"BZ"},
+	"CAD": []string{"CA"},
+	"CDF": []string{"CD"},
+	"CHF": []string{"CH", "LI"},
+	"CLP": []string{"CL"},
+	"CNY": []string{"CN"},
+	"COP": []string{"CO"},
+	"CRC": []string{"CR"},
+	"CUP": []string{"CU"},
+	"CVE": []string{"CV"},
+	"CZK": []string{"CZ"},
+	"DJF": []string{"DJ"},
+	"DKK": []string{"DK", "FO", "GL"},
+	"DOP": []string{"DO"},
+	"DZD": []string{"DZ"},
+	"EGP": []string{"EG", "PS"},
+	"ERN": []string{"ER"},
+	"ETB": []string{"ET"},
+	"EUR": []string{"AD", "AT", "AX", "BE", "BL", "CY", "DE", "EE", "ES", "FI", "FR", "GF", "GP", "GR", "HR", "IE", "IT", "LT", "LU", "LV", "MC", "ME", "MF", "MQ", "MT", "NL", "PM", "PT", "RE", "SI", "SK", "SM", "TF", "VA", "YT"},
+	"FJD": []string{"FJ"},
+	"FKP": []string{"FK"},
+	"GBP": []string{"GB", "GG", "GS", "IM", "JE"},
+	"GEL": []string{"GE"},
+	"GHS": []string{"GH"},
+	"GIP": []string{"GI"},
+	"GMD": []string{"GM"},
+	"GNF": []string{"GN"},
+	"GTQ": []string{"GT"},
+	"GYD": []string{"GY"},
+	"HKD": []string{"HK"},
+	"HNL": []string{"HN"},
+	"HTG": []string{"HT"},
+	"HUF": []string{"HU"},
+	"IDR": []string{"ID", "TL"},
+	"ILS": []string{"IL", "PS"},
+	"INR": []string{"IN"},
+	"IQD": []string{"IQ"},
+	"IRR": []string{"IR"},
+	"ISK": []string{"IS"},
+	"JMD": []string{"JM"},
+	"JOD": []string{"JO"},
+	"JPY": []string{"JP"},
+	"KES": []string{"KE"},
+	"KGS": []string{"KG"},
+	"KHR": []string{"KH"},
+	"KMF": []string{"KM"},
+	"KPW": []string{"KP"},
+	"KRW": []string{"KR"},
+	"KWD": []string{"KW"},
+	"KYD": []string{"KY"},
+	"KZT": []string{"KZ"},
+	"LAK": []string{"LA"},
+	"LBP": []string{"LB"},
+	"LKR": []string{"LK"},
+	"LRD": []string{"LR"},
+	"LSL": []string{"LS"},
+	"LYD": []string{"LY"},
+	"MAD": []string{"EH", "MA"},
+	"MDL": []string{"MD"},
+	"MGA": []string{"MG"},
+	"MKD": []string{"MK"},
+	"MMK": []string{"MM"},
+	"MNT": []string{"MN"},
+	"MOP": []string{"MO"},
+	"MRU": []string{"MR"},
+	"MUR": []string{"MU"},
+	"MVR": []string{"MV"},
+	"MWK": []string{"MW"},
+	"MXN": []string{"MX"},
+	"MYR": []string{"MY"},
+	"MZN": []string{"MZ"},
+	"NAD": []string{"NA"},
+	"NGN": []string{"NG"},
+	"NIO": []string{"NI"},
+	"NOK": []string{"BV", "NO", "SJ"},
+	"NPR": []string{"NP"},
+	"NZD": []string{"CK", "NU", "NZ", "PN", "TK"},
+	"OMR": []string{"OM"},
+	"PAB": []string{"PA"},
+	"PEN": []string{"PE"},
+	"PGK": []string{"PG"},
+	"PHP": []string{"PH"},
+	"PKR": []string{"PK"},
+	"PLN": []string{"PL"},
+	"PYG": []string{"PY"},
+	"QAR": []string{"QA"},
+	"RON": []string{"RO"},
+	"RSD": []string{"RS"},
+	"RUB": []string{"RU", "TJ"},
+	"RWF": []string{"RW"},
+	"SAR": []string{"SA"},
+	"SBD": []string{"SB"},
+	"SCR": []string{"SC"},
+	"SDG": []string{"SD"},
+	"SEK": []string{"SE"},
+	"SGD": []string{"SG"},
+	"SHP": []string{"SH"},
+	"SLE": []string{"SL"},
+	"SOS": []string{"SO"},
+	"SRD": []string{"SR"},
+	"SSP": []string{"SS"},
+	"STN": []string{"ST"},
+	"SYP": []string{"SY"},
+	"SZL": []string{"SZ"},
+	"THB": []string{"TH"},
+	"TJS": []string{"TJ"},
+	"TMT": []string{"TM"},
+	"TND": []string{"TN"},
+	"TOP": []string{"TO"},
+	"TRY": []string{"TR"},
+	"TTD": []string{"TT"},
+	"TWD": []string{"TW"},
+	"TZS": []string{"TZ"},
+	"UAH": []string{"UA"},
+	"UGX": []string{"UG"},
+	"USD": []string{"AQ", "AS", "BQ", "EC", "FM", "GU", "IO", "MH", "MP", "PA", "PR", "PW", "SV", "TC", "UM", "US", "VG", "VI", "ZW"},
+	"UYU": []string{"UY"},
+	"UZS": []string{"UZ"},
+	"VES": []string{"VE"},
+	"VND": []string{"VN"},
+	"VUV": []string{"VU"},
+	"WST": []string{"WS"},
+	"XAF": []string{"CF", "CG", "CM", "GA", "GQ", "TD"},
+	"XCD": []string{"AG", "AI", "DM", "GD", "KN", "LC", "MS", "VC"},
+	"XCG": []string{"CW", "SX"},
+	"XOF": []string{"BF", "BJ", "CI", "GW", "ML", "NE", "SN", "TG"},
+	"XPF": []string{"NC", "PF", "WF"},
+	"YER": []string{"YE"},
+	"ZAR": []string{"LS", "NA", "ZA"},
+	"ZMW": []string{"ZM"},
+}
+
+// moneyFormats maps locales to their money format.
+var moneyFormats = map[string]MoneyFormat{
+	"bg":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"cs":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"da":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"de":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"de_AT": {Decimal: ",", Group: "\u00a0", Pattern: "¤\u00a0#", Grouping: []int(nil)},
+	"de_CH": {Decimal: ".", Group: "’", Pattern: "¤\u00a0#", Grouping: []int(nil)},
+	"el":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"en":    {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+	"en_IN": {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int{3, 2}},
+	"en_ZA": {Decimal: ",", Group: "\u00a0", Pattern: "¤#", Grouping: []int(nil)},
+	"es":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"es_MX": {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+	"es_US": {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+	"et":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"fi":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"fr":    {Decimal: ",", Group: "\u202f", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"fr_CA": {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"he":    {Decimal: ".", Group: ",", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"hi":    {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int{3, 2}},
+	"hr":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"hu":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"it":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"it_CH": {Decimal: ".", Group: "’", Pattern: "¤\u00a0#", Grouping: []int(nil)},
+	"ja":    {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+	"ko":    {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+	"lt":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"lv":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"ms":    {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+	"nb":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"nl":    {Decimal: ",", Group: ".", Pattern: "¤\u00a0#", Grouping: []int(nil)},
+	"pl":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"pt":    {Decimal: ",", Group: ".", Pattern: "¤\u00a0#", Grouping: []int(nil)},
+	"pt_PT": {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"ro":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"ru":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"sk":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"sl":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"sv":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"th":    {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+	"tr":    {Decimal: ",", Group: ".", Pattern: "¤#", Grouping: []int(nil)},
+	"uk":    {Decimal: ",", Group: "\u00a0", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"vi":    {Decimal: ",", Group: ".", Pattern: "#\u00a0¤", Grouping: []int(nil)},
+	"zh":    {Decimal: ".", Group: ",", Pattern: "¤#", Grouping: []int(nil)},
+}
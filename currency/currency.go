@@ -0,0 +1,66 @@
+// Package currency provides the ISO 4217 currencies used by the countries of
+// the countries package: alphabetic and numeric codes, minor units, names and
+// symbols.
+//
+// The currencies are generated, with the countries, from the data directory
+// of the countries package.
+package currency
+
+// Currency is an ISO 4217 currency.
+type Currency struct {
+	// Code is the alphabetic code, like "EUR".
+	Code string `yaml:"-"`
+	// Numeric is the numeric code, like "978".
+	Numeric string `yaml:"numeric"`
+	// MinorUnits is the number of digits after the decimal separator, like 2
+	// for cents.
+	MinorUnits int `yaml:"minor_units"`
+	// Name is the English name.
+	Name string `yaml:"name"`
+	// Translations maps locales to the name of the currency, for some
+	// locales.
+	Translations map[string]string `yaml:"translations"`
+	// Symbol is the symbol of the currency that distinguishes it from the
+	// other currencies with the same local symbol, like "CA$", or the code if
+	// there is none.
+	Symbol string `yaml:"symbol"`
+	// NarrowSymbol is the symbol used in the countries of the currency, like
+	// "$".
+	NarrowSymbol string `yaml:"narrow_symbol"`
+}
+
+// Get returns the currency identified by the alphabetic code. If the currency
+// is not found returns nil.
+func Get(code string) *Currency {
+	if i, found := byCode[code]; found {
+		return &All[i]
+	}
+	return nil
+}
+
+// GetByNumeric returns the currency identified by the numeric code. If the
+// currency is not found returns nil.
+func GetByNumeric(numeric string) *Currency {
+	if i, found := byNumeric[numeric]; found {
+		return &All[i]
+	}
+	return nil
+}
+
+// Countries returns the alpha2 codes of the countries that use the currency,
+// as currency or as alternative currency, ordered by code. If no country uses
+// the currency returns an empty slice.
+func Countries(code string) []string {
+	result := make([]string, len(countriesByCode[code]))
+	copy(result, countriesByCode[code])
+	return result
+}
+
+// TranslatedName returns the name of the currency in locale, falling back to
+// the English name.
+func (c *Currency) TranslatedName(locale string) string {
+	if name := c.Translations[locale]; name != "" {
+		return name
+	}
+	return c.Name
+}
@@ -0,0 +1,117 @@
+package currency_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/pioz/countries/currency"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	c := currency.Get("EUR")
+	if assert.NotNil(t, c) {
+		assert.Equal(t, "978", c.Numeric)
+		assert.Equal(t, 2, c.MinorUnits)
+		assert.Equal(t, "Euro", c.Name)
+		assert.Equal(t, "€", c.Symbol)
+	}
+	c = currency.Get("JPY")
+	if assert.NotNil(t, c) {
+		assert.Equal(t, 0, c.MinorUnits)
+	}
+	assert.Equal(t, 3, currency.Get("KWD").MinorUnits)
+	assert.Equal(t, "CA$", currency.Get("CAD").Symbol)
+	assert.Equal(t, "$", currency.Get("CAD").NarrowSymbol)
+	assert.Nil(t, currency.Get("XXX"))
+	assert.Nil(t, currency.Get("eur"))
+}
+
+func TestGetByNumeric(t *testing.T) {
+	assert.Equal(t, "USD", currency.GetByNumeric("840").Code)
+	assert.Equal(t, "ALL", currency.GetByNumeric("008").Code)
+	assert.Nil(t, currency.GetByNumeric("8"))
+	for i := range currency.All {
+		c := &currency.All[i]
+		assert.Equal(t, c, currency.Get(c.Code))
+		assert.Equal(t, c, currency.GetByNumeric(c.Numeric))
+	}
+}
+
+func TestCountries(t *testing.T) {
+	assert.Contains(t, currency.Countries("EUR"), "IT")
+	assert.Equal(t, []string{"LS", "NA", "ZA"}, currency.Countries("ZAR"))
+	assert.Equal(t, []string{}, currency.Countries("XXX"))
+	for _, c := range currency.All {
+		assert.NotEmpty(t, currency.Countries(c.Code), c.Code)
+	}
+}
+
+func TestTranslatedName(t *testing.T) {
+	c := currency.Get("USD")
+	assert.Equal(t, "dollaro statunitense", c.TranslatedName("it"))
+	assert.Equal(t, "US Dollar", c.TranslatedName("en"))
+	assert.Equal(t, "US Dollar", c.TranslatedName("xx"))
+	assert.Equal(t, "Kwanza", currency.Get("AOA").TranslatedName("it"))
+}
+
+func ExampleGet() {
+	c := currency.Get("EUR")
+	fmt.Println(c.Code, c.Numeric, c.MinorUnits, c.Name, c.Symbol)
+	fmt.Println(c.TranslatedName("de"))
+	// Output:
+	// EUR 978 2 Euro €
+	// Euro
+}
+
+func TestMoneyFormat(t *testing.T) {
+	f, found := currency.MoneyFormatFor("de-CH")
+	assert.True(t, found)
+	assert.Equal(t, "’", f.Group)
+	f, found = currency.MoneyFormatFor("it_SM")
+	assert.True(t, found)
+	assert.Equal(t, ",", f.Decimal)
+	_, found = currency.MoneyFormatFor("xx")
+	assert.False(t, found)
+
+	assert.Equal(t, "0,05\u00a0X", f.Format(5, 2, "X"))
+	assert.Equal(t, "1.000\u00a0X", f.Format(1000, 0, "X"))
+	assert.Equal(t, "-1.234.567,8\u00a0X", f.Format(-12345678, 1, "X"))
+}
+
+func TestCurrencyFormat(t *testing.T) {
+	eur := currency.Get("EUR")
+	assert.Equal(t, "1.234,56\u00a0€", eur.Format(123456, "it"))
+	assert.Equal(t, "€1,234.56", eur.Format(123456, "en"))
+	assert.Equal(t, "€1,234.56", eur.Format(123456, "xx"))
+	assert.Equal(t, "¥1,234", currency.Get("JPY").Format(1234, "ja"))
+}
+
+func TestRescale(t *testing.T) {
+	jpy := currency.Get("JPY")
+	kwd := currency.Get("KWD")
+	for _, test := range []struct {
+		cur        *currency.Currency
+		amount     int64
+		minorUnits int
+		expected   int64
+	}{
+		{jpy, 123456, 2, 1235},
+		{jpy, 123449, 2, 1234},
+		{jpy, -123450, 2, -1235},
+		{jpy, 1234, 0, 1234},
+		{kwd, 12345, 2, 123450},
+		{jpy, math.MaxInt64, 2, 92233720368547758},
+	} {
+		amount, err := test.cur.Rescale(test.amount, test.minorUnits)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, amount, "%d %d", test.amount, test.minorUnits)
+	}
+
+	_, err := kwd.Rescale(math.MaxInt64/2, 0)
+	assert.True(t, errors.Is(err, currency.ErrOverflow))
+	_, err = kwd.Rescale(math.MinInt64/2, 0)
+	assert.True(t, errors.Is(err, currency.ErrOverflow))
+}
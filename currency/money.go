@@ -0,0 +1,112 @@
+package currency
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MoneyFormat is the convention of a locale to write money amounts.
+type MoneyFormat struct {
+	// Decimal is the decimal separator.
+	Decimal string `yaml:"decimal"`
+	// Group is the separator of the digit groups of the integer part.
+	Group string `yaml:"group"`
+	// Pattern places the currency symbol, "¤", and the amount, "#", like
+	// "# ¤" for "1.234,56 €".
+	Pattern string `yaml:"pattern"`
+	// Grouping are the sizes of the digit groups of the integer part, from
+	// the right; the last size repeats. If empty, digits are grouped by
+	// three.
+	Grouping []int `yaml:"grouping"`
+}
+
+// MoneyFormatFor returns the money format of the locale, like "it" or
+// "de_CH". A locale with a region falls back to its language. If the locale
+// is not found returns the English format and false.
+func MoneyFormatFor(locale string) (MoneyFormat, bool) {
+	locale = strings.ReplaceAll(locale, "-", "_")
+	if f, found := moneyFormats[locale]; found {
+		return f, true
+	}
+	if i := strings.Index(locale, "_"); i > 0 {
+		if f, found := moneyFormats[locale[:i]]; found {
+			return f, true
+		}
+	}
+	return moneyFormats["en"], false
+}
+
+// Format returns the amount, in minor units, with minorUnits digits after the
+// decimal separator, placed with the symbol in the format pattern. Negative
+// amounts are prefixed by a minus sign.
+func (f MoneyFormat) Format(amount int64, minorUnits int, symbol string) string {
+	digits := strconv.FormatUint(absInt64(amount), 10)
+	if len(digits) <= minorUnits {
+		digits = strings.Repeat("0", minorUnits-len(digits)+1) + digits
+	}
+	integer, fraction := digits[:len(digits)-minorUnits], digits[len(digits)-minorUnits:]
+	number := f.group(integer)
+	if fraction != "" {
+		number += f.Decimal + fraction
+	}
+	s := strings.Replace(f.Pattern, "#", number, 1)
+	s = strings.Replace(s, "¤", symbol, 1)
+	if amount < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// group returns the integer digits with the group separators.
+func (f MoneyFormat) group(integer string) string {
+	grouping := f.Grouping
+	if len(grouping) == 0 {
+		grouping = []int{3}
+	}
+	var groups []string
+	for i := 0; len(integer) > 0; i++ {
+		size := grouping[len(grouping)-1]
+		if i < len(grouping) {
+			size = grouping[i]
+		}
+		if size >= len(integer) {
+			groups = append(groups, integer)
+			break
+		}
+		groups = append(groups, integer[len(integer)-size:])
+		integer = integer[:len(integer)-size]
+	}
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+	return strings.Join(groups, f.Group)
+}
+
+// Format returns the amount, in minor units of the currency, formatted with
+// the conventions of the locale and the currency symbol, like "1.234,56 €".
+// Unknown locales are formatted in English.
+func (c *Currency) Format(amount int64, locale string) string {
+	f, _ := MoneyFormatFor(locale)
+	return f.Format(amount, c.MinorUnits, c.Symbol)
+}
+
+// Rescale converts an amount with minorUnits digits after the decimal
+// separator to the minor units of the currency, rounding half away from zero:
+// 123456 with 2 minor units is 1235 yen. It returns an error wrapping
+// ErrOverflow if the result does not fit in an int64.
+func (c *Currency) Rescale(amount int64, minorUnits int) (int64, error) {
+	if minorUnits == c.MinorUnits {
+		return amount, nil
+	}
+	value := new(big.Rat).SetInt64(amount)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(c.MinorUnits), pow10(minorUnits)))
+	return roundRat(value)
+}
+
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-(n + 1)) + 1
+	}
+	return uint64(n)
+}
@@ -0,0 +1,250 @@
+package currency
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnknownCurrency is returned when a currency code is not an ISO 4217
+	// currency code.
+	ErrUnknownCurrency = errors.New("currency: unknown currency")
+	// ErrRateNotFound is returned when a provider has no exchange rate for a
+	// pair of currencies.
+	ErrRateNotFound = errors.New("currency: exchange rate not found")
+	// ErrInvalidRates is returned when exchange rates cannot be parsed.
+	ErrInvalidRates = errors.New("currency: invalid exchange rates")
+	// ErrOverflow is returned when a converted amount does not fit in an
+	// int64.
+	ErrOverflow = errors.New("currency: amount overflows int64")
+)
+
+// RateProvider provides exchange rates between currencies.
+type RateProvider interface {
+	// Rate returns the number of units of the currency to that one unit of
+	// the currency from buys. It returns an error wrapping ErrRateNotFound if
+	// the rate is not known.
+	Rate(from, to string) (*big.Rat, error)
+}
+
+// MemoryProvider is a RateProvider with rates relative to a base currency;
+// rates between other currencies are crossed through the base.
+type MemoryProvider struct {
+	base  string
+	rates map[string]*big.Rat
+}
+
+// NewMemoryProvider returns a provider with the rates, as decimal strings like
+// "1.0823", of one unit of the base currency in the currencies of the map. It
+// returns an error wrapping ErrInvalidRates if a rate is not a positive
+// number.
+func NewMemoryProvider(base string, rates map[string]string) (*MemoryProvider, error) {
+	p := &MemoryProvider{base: base, rates: make(map[string]*big.Rat)}
+	for code, rate := range rates {
+		if err := p.set(code, rate); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+func (p *MemoryProvider) set(code, rate string) error {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(rate))
+	if !ok || r.Sign() <= 0 {
+		return fmt.Errorf("%w: %s rate %q", ErrInvalidRates, code, rate)
+	}
+	p.rates[code] = r
+	return nil
+}
+
+// Rate returns the exchange rate between the currencies from and to.
+func (p *MemoryProvider) Rate(from, to string) (*big.Rat, error) {
+	fromRate, found := p.rate(from)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrRateNotFound, from)
+	}
+	toRate, found := p.rate(to)
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrRateNotFound, to)
+	}
+	return new(big.Rat).Quo(toRate, fromRate), nil
+}
+
+func (p *MemoryProvider) rate(code string) (*big.Rat, bool) {
+	if code == p.base {
+		return big.NewRat(1, 1), true
+	}
+	r, found := p.rates[code]
+	return r, found
+}
+
+// FileProvider is a RateProvider loaded from a snapshot of the euro foreign
+// exchange reference rates of the European Central Bank, in XML or CSV
+// format. Only the most recent day of historical snapshots is loaded.
+type FileProvider struct {
+	MemoryProvider
+	// Date is the day of the rates.
+	Date time.Time
+}
+
+// NewFileProvider loads the rates from the snapshot file at path. Files with
+// the ".csv" extension are read as CSV, the others as XML. It returns an error
+// wrapping ErrInvalidRates if the file cannot be parsed.
+func NewFileProvider(path string) (*FileProvider, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	p := &FileProvider{MemoryProvider: MemoryProvider{base: "EUR", rates: make(map[string]*big.Rat)}}
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		err = p.readCSV(f)
+	} else {
+		err = p.readXML(f)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type ecbEnvelope struct {
+	Cube struct {
+		Days []struct {
+			Time  string `xml:"time,attr"`
+			Rates []struct {
+				Currency string `xml:"currency,attr"`
+				Rate     string `xml:"rate,attr"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	} `xml:"Cube"`
+}
+
+func (p *FileProvider) readXML(r io.Reader) error {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRates, err)
+	}
+	if len(envelope.Cube.Days) == 0 {
+		return fmt.Errorf("%w: no rates", ErrInvalidRates)
+	}
+	day := envelope.Cube.Days[0]
+	if err := p.setDate(day.Time); err != nil {
+		return err
+	}
+	for _, rate := range day.Rates {
+		if err := p.set(rate.Currency, rate.Rate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *FileProvider) readCSV(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRates, err)
+	}
+	row, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidRates, err)
+	}
+	if len(row) == 0 || len(row) > len(header) {
+		return fmt.Errorf("%w: invalid row", ErrInvalidRates)
+	}
+	if err := p.setDate(row[0]); err != nil {
+		return err
+	}
+	for i := 1; i < len(row); i++ {
+		code, rate := strings.TrimSpace(header[i]), strings.TrimSpace(row[i])
+		if code == "" || rate == "" || rate == "N/A" {
+			continue
+		}
+		if err := p.set(code, rate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ecbDateLayouts are the layouts of the dates of the daily and of the
+// historical snapshots.
+var ecbDateLayouts = []string{"2006-01-02", "02 January 2006"}
+
+func (p *FileProvider) setDate(s string) error {
+	for _, layout := range ecbDateLayouts {
+		if date, err := time.Parse(layout, strings.TrimSpace(s)); err == nil {
+			p.Date = date
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: date %q", ErrInvalidRates, s)
+}
+
+// Converter converts amounts between currencies with the rates of a provider.
+type Converter struct {
+	Provider RateProvider
+}
+
+// NewConverter returns a converter using the rates of the provider.
+func NewConverter(p RateProvider) *Converter {
+	return &Converter{Provider: p}
+}
+
+// Convert converts the amount, in minor units of the currency from, to minor
+// units of the currency to, rounding half away from zero. It returns an error
+// wrapping ErrUnknownCurrency if a currency is unknown, the provider error if
+// the rate is not found or an error wrapping ErrOverflow if the converted
+// amount does not fit in an int64.
+func (c *Converter) Convert(amount int64, from, to string) (int64, error) {
+	fromCurrency, toCurrency := Get(from), Get(to)
+	if fromCurrency == nil {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, from)
+	}
+	if toCurrency == nil {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownCurrency, to)
+	}
+	if from == to {
+		return amount, nil
+	}
+	rate, err := c.Provider.Rate(from, to)
+	if err != nil {
+		return 0, err
+	}
+	value := new(big.Rat).SetInt64(amount)
+	value.Mul(value, rate)
+	value.Mul(value, new(big.Rat).SetFrac(pow10(toCurrency.MinorUnits), pow10(fromCurrency.MinorUnits)))
+	return roundRat(value)
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundRat returns r rounded half away from zero. It returns an error wrapping
+// ErrOverflow if the result does not fit in an int64.
+func roundRat(r *big.Rat) (int64, error) {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(m), big.NewInt(2)).Cmp(r.Denom()) >= 0 {
+		if r.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	if !q.IsInt64() {
+		return 0, fmt.Errorf("%w: %s", ErrOverflow, q)
+	}
+	return q.Int64(), nil
+}
@@ -0,0 +1,121 @@
+package currency_test
+
+import (
+	"errors"
+	"io/fs"
+	"math"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pioz/countries/currency"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryProvider(t *testing.T) {
+	p, err := currency.NewMemoryProvider("EUR", map[string]string{"USD": "1.1", "JPY": "160"})
+	assert.Nil(t, err)
+	rate, err := p.Rate("EUR", "USD")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewRat(11, 10), rate)
+	rate, err = p.Rate("USD", "JPY")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewRat(1600, 11), rate)
+	rate, err = p.Rate("JPY", "EUR")
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewRat(1, 160), rate)
+	_, err = p.Rate("EUR", "GBP")
+	assert.True(t, errors.Is(err, currency.ErrRateNotFound))
+
+	_, err = currency.NewMemoryProvider("EUR", map[string]string{"USD": "abc"})
+	assert.True(t, errors.Is(err, currency.ErrInvalidRates))
+	_, err = currency.NewMemoryProvider("EUR", map[string]string{"USD": "0"})
+	assert.True(t, errors.Is(err, currency.ErrInvalidRates))
+}
+
+func TestFileProvider(t *testing.T) {
+	for _, name := range []string{"eurofxref-daily.xml", "eurofxref.csv", "eurofxref-hist.csv"} {
+		p, err := currency.NewFileProvider(filepath.Join("testdata", name))
+		if !assert.Nil(t, err, name) {
+			continue
+		}
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), p.Date, name)
+		rate, err := p.Rate("EUR", "JPY")
+		assert.Nil(t, err, name)
+		assert.Equal(t, big.NewRat(15573, 100), rate, name)
+		rate, err = p.Rate("CHF", "GBP")
+		assert.Nil(t, err, name)
+		assert.Equal(t, new(big.Rat).Quo(big.NewRat(86518, 100000), big.NewRat(9305, 10000)), rate, name)
+	}
+
+	p, err := currency.NewFileProvider(filepath.Join("testdata", "eurofxref-hist.csv"))
+	assert.Nil(t, err)
+	_, err = p.Rate("EUR", "RUB")
+	assert.True(t, errors.Is(err, currency.ErrRateNotFound))
+
+	_, err = currency.NewFileProvider(filepath.Join("testdata", "missing.xml"))
+	assert.True(t, errors.Is(err, fs.ErrNotExist))
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"invalid.xml":  "<Envelope><Cube>",
+		"empty.xml":    "<Envelope></Envelope>",
+		"date.csv":     "Date,USD\nyesterday,1.1\n",
+		"rate.csv":     "Date,USD\n2024-01-02,-1\n",
+		"header.csv":   "Date,USD\n",
+		"columns.csv":  "Date,USD\n2024-01-02,1.1,2.2\n",
+		"timeless.xml": "<Envelope><Cube><Cube><Cube currency='USD' rate='1.1'/></Cube></Cube></Envelope>",
+	} {
+		path := filepath.Join(dir, name)
+		assert.Nil(t, os.WriteFile(path, []byte(content), 0644))
+		_, err = currency.NewFileProvider(path)
+		assert.True(t, errors.Is(err, currency.ErrInvalidRates), name)
+	}
+}
+
+func TestConverter(t *testing.T) {
+	p, err := currency.NewFileProvider(filepath.Join("testdata", "eurofxref-daily.xml"))
+	assert.Nil(t, err)
+	c := currency.NewConverter(p)
+	for _, test := range []struct {
+		amount   int64
+		from, to string
+		expected int64
+	}{
+		{10000, "EUR", "USD", 10956},
+		{10000, "EUR", "JPY", 15573},
+		{15573, "JPY", "EUR", 10000},
+		{10956, "USD", "GBP", 8652},
+		{-10956, "USD", "GBP", -8652},
+		{10000, "EUR", "KWD", 33691},
+		{10000, "EUR", "EUR", 10000},
+		{0, "USD", "JPY", 0},
+	} {
+		amount, err := c.Convert(test.amount, test.from, test.to)
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, amount, "%d %s to %s", test.amount, test.from, test.to)
+	}
+
+	_, err = c.Convert(100, "EUR", "XXX")
+	assert.True(t, errors.Is(err, currency.ErrUnknownCurrency))
+	_, err = c.Convert(100, "EUR", "SEK")
+	assert.True(t, errors.Is(err, currency.ErrRateNotFound))
+
+	// Half minor units are rounded away from zero.
+	p2, _ := currency.NewMemoryProvider("EUR", map[string]string{"USD": "0.5"})
+	c = currency.NewConverter(p2)
+	amount, _ := c.Convert(1, "EUR", "USD")
+	assert.Equal(t, int64(1), amount)
+	amount, _ = c.Convert(-1, "EUR", "USD")
+	assert.Equal(t, int64(-1), amount)
+
+	// Converted amounts must fit in an int64.
+	p3, _ := currency.NewMemoryProvider("EUR", map[string]string{"USD": "2"})
+	c = currency.NewConverter(p3)
+	_, err = c.Convert(math.MaxInt64, "EUR", "USD")
+	assert.True(t, errors.Is(err, currency.ErrOverflow))
+	_, err = c.Convert(math.MinInt64, "EUR", "USD")
+	assert.True(t, errors.Is(err, currency.ErrOverflow))
+}
@@ -0,0 +1,69 @@
+package countries_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/pioz/countries/currency"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountryCurrency(t *testing.T) {
+	assert.Equal(t, "EUR", countries.Get("IT").Currency().Code)
+	assert.Equal(t, []*currency.Currency{currency.Get("PAB"), currency.Get("USD")}, countries.Get("PA").Currencies())
+	for _, c := range countries.All {
+		if c.CurrencyCode == "" {
+			assert.Nil(t, c.Currency(), c.Alpha2)
+			continue
+		}
+		if assert.NotNil(t, c.Currency(), c.Alpha2) {
+			assert.Contains(t, currency.Countries(c.CurrencyCode), c.Alpha2)
+		}
+	}
+}
+
+func formatMoney(t *testing.T, alpha2 string, amount int64, cur *currency.Currency) string {
+	s, err := countries.Get(alpha2).FormatMoney(amount, cur)
+	assert.Nil(t, err)
+	return s
+}
+
+func TestFormatMoney(t *testing.T) {
+	eur := currency.Get("EUR")
+	assert.Equal(t, "1.234,56\u00a0€", formatMoney(t, "IT", 123456, eur))
+	assert.Equal(t, "€1,234.56", formatMoney(t, "IE", 123456, eur))
+	assert.Equal(t, "1\u202f234,56\u00a0€", formatMoney(t, "FR", 123456, eur))
+	assert.Equal(t, "-€0.05", formatMoney(t, "IE", -5, eur))
+	jpy := currency.Get("JPY")
+	amount, err := jpy.Rescale(123456, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, "¥1,235", formatMoney(t, "JP", amount, jpy))
+	cad := currency.Get("CAD")
+	assert.Equal(t, "$1,234.56", formatMoney(t, "CA", 123456, cad))
+	assert.Equal(t, "CA$1,234.56", formatMoney(t, "US", 123456, cad))
+	assert.Equal(t, "CHF\u00a01’234.56", formatMoney(t, "CH", 123456, currency.Get("CHF")))
+	assert.Equal(t, "₹12,34,567.00", formatMoney(t, "IN", 123456700, currency.Get("INR")))
+	assert.Equal(t, "1.234,567\u00a0KWD", formatMoney(t, "IT", 1234567, currency.Get("KWD")))
+	assert.Equal(t, "1\u00a0234,56\u00a0zł", formatMoney(t, "PL", 123456, currency.Get("PLN")))
+	// Countries without a money format use the English one.
+	assert.Equal(t, "$1,234.56", formatMoney(t, "AQ", 123456, currency.Get("USD")))
+
+	_, err = countries.Get("IT").FormatMoney(100, currency.Get("XXX"))
+	assert.True(t, errors.Is(err, currency.ErrUnknownCurrency))
+}
+
+func TestConvertMoney(t *testing.T) {
+	p, err := currency.NewMemoryProvider("EUR", map[string]string{"USD": "1.0956", "JPY": "155.73"})
+	assert.Nil(t, err)
+	amount, err := countries.Get("IT").ConvertMoney(10000, countries.Get("US"), p)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10956), amount)
+	amount, err = countries.Get("US").ConvertMoney(10956, countries.Get("JP"), p)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(15573), amount)
+	_, err = countries.Get("IT").ConvertMoney(10000, countries.Get("GB"), p)
+	assert.True(t, errors.Is(err, currency.ErrRateNotFound))
+	_, err = countries.Get("IT").ConvertMoney(10000, nil, p)
+	assert.True(t, errors.Is(err, currency.ErrUnknownCurrency))
+}
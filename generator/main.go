@@ -8,10 +8,14 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp/syntax"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/pioz/countries"
+	"github.com/pioz/countries/currency"
 	"gopkg.in/yaml.v3"
 )
 
@@ -35,6 +39,30 @@ func (g *Generator) format() []byte {
 	return src
 }
 
+// printFinder prints a function named name that returns the country whose key
+// matches the function argument. Countries with an empty key are skipped and,
+// if more countries share the same key, the first one wins.
+func (g *Generator) printFinder(name, arg string, all []countries.Country, key func(countries.Country) string) {
+	g.Printf("func %s(%s string) *Country {\n", name, arg)
+	g.Printf("  switch %s {\n", arg)
+	seen := make(map[string]struct{})
+	for i, country := range all {
+		k := key(country)
+		if k == "" {
+			continue
+		}
+		if _, found := seen[k]; found {
+			continue
+		}
+		seen[k] = struct{}{}
+		g.Printf("  case %q:\n", k)
+		g.Printf("    return &All[%d]\n", i)
+	}
+	g.Printf("  }\n")
+	g.Printf("  return nil\n")
+	g.Printf("}\n")
+}
+
 func main() {
 	if len(os.Args) != 2 {
 		fmt.Fprintf(os.Stderr, "Usage %s <countries/data/path>\n", os.Args[0])
@@ -62,6 +90,70 @@ func main() {
 	if err != nil {
 		log.Fatalf("writing output: %s", err)
 	}
+	// Load subdivision parents data from yaml data file
+	allParents := make(map[string]map[string]string)
+	err = loadParents(filepath.Join(dataPath, "subdivision_parents.yaml"), allParents)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	// Load subdivision type labels data from yaml data file
+	allTypeLabels := make(map[string]map[string]string)
+	err = loadTypeLabels(filepath.Join(dataPath, "subdivision_type_labels.yaml"), allTypeLabels)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	// Load postal code ranges data from yaml data file
+	allPostalCodes := make(map[string]map[string][]string)
+	err = loadPostalCodes(filepath.Join(dataPath, "postal_codes.yaml"), allPostalCodes)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	// Load address required fields data from yaml data file
+	allRequiredFields := make(map[string][]string)
+	err = loadRequiredFields(filepath.Join(dataPath, "address_required_fields.yaml"), allRequiredFields)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	for alpha2 := range allRequiredFields {
+		if _, found := allCountries[alpha2]; !found {
+			log.Fatalf("writing output: %s: unknown country", alpha2)
+		}
+	}
+	// Load address transforms data from yaml data file
+	allAddressTransforms := make(map[string]map[string][]string)
+	err = loadAddressTransforms(filepath.Join(dataPath, "address_transforms.yaml"), allAddressTransforms)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	for alpha2 := range allAddressTransforms {
+		if _, found := allCountries[alpha2]; !found {
+			log.Fatalf("writing output: %s: unknown country", alpha2)
+		}
+	}
+	// Load address field labels data from yaml data file
+	allFieldLabels := make(map[string]map[string]string)
+	err = loadFieldLabels(filepath.Join(dataPath, "address_field_labels.yaml"), allFieldLabels)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	// Load phone numbering plans data from yaml data file
+	allNumberingPlans := make(map[string]numberingPlan)
+	err = loadNumberingPlans(filepath.Join(dataPath, "phone_numbering.yaml"), allNumberingPlans)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	// Load currencies data from yaml data file
+	allCurrencies := make(map[string]currency.Currency)
+	err = loadCurrencies(filepath.Join(dataPath, "currencies.yaml"), allCurrencies)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	// Load money formats data from yaml data file
+	allMoneyFormats := make(map[string]currency.MoneyFormat)
+	err = loadMoneyFormats(filepath.Join(dataPath, "money_formats.yaml"), allMoneyFormats)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
 	// Load translations data from yaml data files
 	allTranslations := make(map[string]map[string]string)
 	err = loadTranslations(filepath.Join(dataPath, "translations"), allTranslations)
@@ -89,8 +181,18 @@ func main() {
 			if subdivision.Type == "metropolitan_city" && subdivision.Translations["en"] == c.Capital {
 				subdivision.Capital = true
 			}
+			subdivision.CountryAlpha2 = countryAlpha2
+			subdivision.ParentCode = allParents[countryAlpha2][code]
 			c.Subdivisions[code] = *subdivision
 		}
+		err = checkParents(c, allParents[countryAlpha2])
+		if err != nil {
+			log.Fatalf("writing output: %s", err)
+		}
+		_, err = countries.ParseAddressTemplate(c.AddressFormat)
+		if err != nil {
+			log.Fatalf("writing output: %s: %s", countryAlpha2, err)
+		}
 		c.Timezones = allTimezones[countryAlpha2]
 		c.Translations = make(map[string]string)
 		for locale, translations := range allTranslations {
@@ -118,15 +220,29 @@ func main() {
 
 	g.Printf("\n")
 	g.Printf("// Get returns the country identified by alpha2 code.\n")
-	g.Printf("func Get(alpha2 string) *Country {\n")
-	g.Printf("  switch alpha2 {\n")
-	for i, country := range all {
-		g.Printf("  case \"%s\":\n", country.Alpha2)
-		g.Printf("    return &All[%d]\n", i)
-	}
-	g.Printf("  }\n")
-	g.Printf("  return nil\n")
-	g.Printf("}\n")
+	g.printFinder("Get", "alpha2", all, func(c countries.Country) string { return c.Alpha2 })
+
+	g.Printf("\n")
+	g.Printf("// GetByAlpha3 returns the country identified by alpha3 code.\n")
+	g.printFinder("GetByAlpha3", "alpha3", all, func(c countries.Country) string { return c.Alpha3 })
+
+	g.Printf("\n")
+	g.Printf("// GetByNumeric returns the country identified by ISO 3166-1 numeric code.\n")
+	g.printFinder("GetByNumeric", "number", all, func(c countries.Country) string { return c.Number })
+
+	g.Printf("\n")
+	g.Printf("// GetByIOC returns the country identified by IOC (International Olympic\n")
+	g.Printf("// Committee) code.\n")
+	g.printFinder("GetByIOC", "ioc", all, func(c countries.Country) string { return c.IOC })
+
+	g.Printf("\n")
+	g.Printf("// GetByGEC returns the country identified by GEC (Geopolitical Entities and\n")
+	g.Printf("// Codes, formerly FIPS 10-4) code.\n")
+	g.printFinder("GetByGEC", "gec", all, func(c countries.Country) string { return c.GEC })
+
+	g.Printf("\n")
+	g.Printf("// GetByUnLocode returns the country identified by UN/LOCODE country code.\n")
+	g.printFinder("GetByUnLocode", "unLocode", all, func(c countries.Country) string { return c.UnLocode })
 
 	g.Printf("\n")
 	g.Printf("// Alpha2 is a slice with all country alpha2 codes.\n")
@@ -140,6 +256,158 @@ func main() {
 	g.Printf("// Subregions is a slice with all subregion names.\n")
 	g.Printf("var Subregions = %#v\n", subregions(all))
 
+	g.Printf("\n")
+	g.Printf("// Subdivision types.\n")
+	g.Printf("const (\n")
+	types := subdivisionTypes(all)
+	for _, t := range types {
+		g.Printf("  %s SubdivisionType = %q\n", subdivisionTypeConstName(t), t)
+	}
+	g.Printf(")\n")
+
+	g.Printf("\n")
+	g.Printf("// SubdivisionTypes is a slice with all subdivision types.\n")
+	g.Printf("var SubdivisionTypes = []SubdivisionType{\n")
+	for _, t := range types {
+		g.Printf("  %s,\n", subdivisionTypeConstName(t))
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// subdivisionTypeLabels maps subdivision types to their labels translated in\n")
+	g.Printf("// some locales.\n")
+	g.Printf("var subdivisionTypeLabels = map[SubdivisionType]map[string]string{\n")
+	typeLabels, err := subdivisionTypeLabels(types, allTypeLabels)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	for _, t := range types {
+		if labels, found := typeLabels[t]; found {
+			g.Printf("  %s: %#v,\n", subdivisionTypeConstName(t), labels)
+		}
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// postalCodeRanges maps country alpha2 codes to the postal code ranges of\n")
+	g.Printf("// their subdivisions, most specific first.\n")
+	g.Printf("var postalCodeRanges = map[string][]postalCodeRange{\n")
+	for _, c := range all {
+		ranges, err := postalCodeRanges(c, allPostalCodes[c.Alpha2])
+		if err != nil {
+			log.Fatalf("writing output: %s", err)
+		}
+		if len(ranges) == 0 {
+			continue
+		}
+		g.Printf("  %q: {", c.Alpha2)
+		for _, r := range ranges {
+			g.Printf("{%q, %q, %q}, ", r.from, r.to, r.code)
+		}
+		g.Printf("},\n")
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// postalCodeHints maps country alpha2 codes to an example postal code and\n")
+	g.Printf("// a human-readable hint derived from the country postal code format.\n")
+	g.Printf("var postalCodeHints = map[string]postalCodeHint{\n")
+	for _, c := range all {
+		if c.PostalCodeFormat == "" {
+			continue
+		}
+		example, hint, err := postalCodeExample(c.PostalCodeFormat)
+		if err != nil {
+			log.Fatalf("writing output: %s: %s", c.Alpha2, err)
+		}
+		g.Printf("  %q: {%q, %q},\n", c.Alpha2, example, hint)
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// addressRequiredFields maps country alpha2 codes to the names of the\n")
+	g.Printf("// Address fields required by the country, when they differ from the default.\n")
+	g.Printf("var addressRequiredFields = map[string][]string{\n")
+	for _, c := range all {
+		fields, found := allRequiredFields[c.Alpha2]
+		if !found {
+			continue
+		}
+		err = checkRequiredFields(c, fields)
+		if err != nil {
+			log.Fatalf("writing output: %s: %s", c.Alpha2, err)
+		}
+		g.Printf("  %q: %#v,\n", c.Alpha2, fields)
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// addressFormatTransforms maps country alpha2 codes to the transforms applied\n")
+	g.Printf("// to the fields of the country address format.\n")
+	g.Printf("var addressFormatTransforms = map[string]map[string][]string{\n")
+	for _, c := range all {
+		transforms, found := allAddressTransforms[c.Alpha2]
+		if !found {
+			continue
+		}
+		err = checkAddressTransforms(c, transforms)
+		if err != nil {
+			log.Fatalf("writing output: %s: %s", c.Alpha2, err)
+		}
+		g.Printf("  %q: %#v,\n", c.Alpha2, transforms)
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// addressFieldLabels maps the names of the Address fields to their labels\n")
+	g.Printf("// translated in some locales.\n")
+	g.Printf("var addressFieldLabels = map[string]map[string]string{\n")
+	fields := make([]string, 0, len(allFieldLabels))
+	for field := range allFieldLabels {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		g.Printf("  %q: %#v,\n", field, allFieldLabels[field])
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// nanpAreaCodes maps the area codes of the North American Numbering Plan to\n")
+	g.Printf("// the alpha2 codes of their countries.\n")
+	g.Printf("var nanpAreaCodes = map[string][]string{\n")
+	areaCodes, err := nanpAreaCodes(all, allNumberingPlans)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	codes := make([]string, 0, len(areaCodes))
+	for code := range areaCodes {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	for _, code := range codes {
+		g.Printf("  %q: %#v,\n", code, areaCodes[code])
+	}
+	g.Printf("}\n")
+
+	g.Printf("\n")
+	g.Printf("// nameIndex maps normalized country names, in all locales, to countries.\n")
+	g.Printf("var nameIndex = map[string][]nameIndexEntry{\n")
+	index := nameIndex(all)
+	keys := make([]string, 0, len(index))
+	for key := range index {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		g.Printf("  %q: {", key)
+		for _, e := range index[key] {
+			g.Printf("{%d, %q, %q}, ", e.country, e.name, e.locale)
+		}
+		g.Printf("},\n")
+	}
+	g.Printf("}\n")
+
 	// Format the output.
 	src := g.format()
 
@@ -148,6 +416,26 @@ func main() {
 	if err != nil {
 		log.Fatalf("writing output: %s", err)
 	}
+
+	// Generate the currency package data
+	src, err = currenciesSource(all, allCurrencies, allMoneyFormats)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	err = os.WriteFile(filepath.Join("currency", "currencies.go"), src, 0644)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+
+	// Generate the phone package data
+	src, err = numberingSource(all, allNumberingPlans)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
+	err = os.WriteFile(filepath.Join("phone", "numbering.go"), src, 0644)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
 }
 
 func loadCountries(countriesPath string, out map[string]countries.Country) error {
@@ -165,6 +453,41 @@ func loadCountries(countriesPath string, out map[string]countries.Country) error
 		if err != nil {
 			return err
 		}
+		err = checkUnknownKeys(path, buf, reflect.TypeOf(countries.Country{}))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkUnknownKeys returns an error if the yaml data file in buf, a map of
+// objects, contains keys that are not mapped to a field of type t. In this
+// way new fields added upstream are not silently discarded.
+func checkUnknownKeys(path string, buf []byte, t reflect.Type) error {
+	var data map[string]map[string]interface{}
+	err := yaml.Unmarshal(buf, &data)
+	if err != nil {
+		return err
+	}
+	known := make(map[string]struct{})
+	for i := 0; i < t.NumField(); i++ {
+		key := strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0]
+		if key != "" && key != "-" {
+			known[key] = struct{}{}
+		}
+	}
+	var unknown []string
+	for _, fields := range data {
+		for key := range fields {
+			if _, found := known[key]; !found {
+				unknown = append(unknown, key)
+			}
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("%s: unknown keys %s", path, strings.Join(unknown, ", "))
 	}
 	return nil
 }
@@ -185,36 +508,53 @@ func loadSubdivisions(subdivisionsPath string, out map[string]map[string]*countr
 		if err != nil {
 			panic(err)
 		}
+		err = checkUnknownKeys(path, buf, reflect.TypeOf(countries.Subdivision{}))
+		if err != nil {
+			return err
+		}
 		countryAlpha2 := filenameToCountryAlpha2(file.Name())
 		out[countryAlpha2] = subdivisions
 	}
 	return nil
 }
 
-func loadTranslations(translationsPath string, out map[string]map[string]string) error {
-	files, err := os.ReadDir(translationsPath)
+func loadParents(parentsPath string, out map[string]map[string]string) error {
+	buf, err := os.ReadFile(parentsPath)
 	if err != nil {
 		return err
 	}
-	for _, file := range files {
-		translations := make(map[string]string)
-		path := filepath.Join(translationsPath, file.Name())
-		buf, err := os.ReadFile(path)
-		if err != nil {
-			return err
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkParents returns an error if parents refers to subdivisions that do not
+// exist in the country or if the parent relationships contain a cycle.
+func checkParents(c countries.Country, parents map[string]string) error {
+	for code := range parents {
+		if _, found := c.Subdivisions[code]; !found {
+			return fmt.Errorf("%s-%s: unknown subdivision", c.Alpha2, code)
 		}
-		err = yaml.Unmarshal(buf, &translations)
-		if err != nil {
-			return err
+	}
+	for code, subdivision := range c.Subdivisions {
+		seen := map[string]struct{}{code: {}}
+		for parent := subdivision.ParentCode; parent != ""; parent = c.Subdivisions[parent].ParentCode {
+			if _, found := c.Subdivisions[parent]; !found {
+				return fmt.Errorf("%s-%s: unknown parent %s", c.Alpha2, code, parent)
+			}
+			if _, found := seen[parent]; found {
+				return fmt.Errorf("%s-%s: cyclic parent %s", c.Alpha2, code, parent)
+			}
+			seen[parent] = struct{}{}
 		}
-		locale := filenameToLocale(file.Name())
-		out[locale] = translations
 	}
 	return nil
 }
 
-func loadCapitals(capitalsPath string, out map[string]string) error {
-	buf, err := os.ReadFile(capitalsPath)
+func loadTypeLabels(typeLabelsPath string, out map[string]map[string]string) error {
+	buf, err := os.ReadFile(typeLabelsPath)
 	if err != nil {
 		return err
 	}
@@ -225,74 +565,1077 @@ func loadCapitals(capitalsPath string, out map[string]string) error {
 	return nil
 }
 
-// CSV file link: https://timezonedb.com/files/timezonedb.csv.zip
-func loadTimezones(timezonesPath string, out map[string][]string) error {
-	f, err := os.Open(timezonesPath)
+// subdivisionTypeLabels returns the labels of the subdivision types. It returns
+// an error if a label refers to an unknown type.
+func subdivisionTypeLabels(types []countries.SubdivisionType, data map[string]map[string]string) (map[countries.SubdivisionType]map[string]string, error) {
+	result := make(map[countries.SubdivisionType]map[string]string)
+	for t, labels := range data {
+		known := false
+		for _, k := range types {
+			if string(k) == t {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown subdivision type %q", t)
+		}
+		result[countries.SubdivisionType(t)] = labels
+	}
+	return result, nil
+}
+
+func loadPostalCodes(postalCodesPath string, out map[string]map[string][]string) error {
+	buf, err := os.ReadFile(postalCodesPath)
 	if err != nil {
 		return err
 	}
-	defer f.Close()
-	csvReader := csv.NewReader(f)
-	records, err := csvReader.ReadAll()
+	err = yaml.Unmarshal(buf, &out)
 	if err != nil {
 		return err
 	}
-	for _, row := range records {
-		out[row[1]] = append(out[row[1]], row[2])
-	}
 	return nil
 }
 
-func countryToCodeString(c countries.Country) string {
-	s := fmt.Sprintf("%#v", c)
-	s = strings.ReplaceAll(s, "countries.", "")
-	s = strings.ReplaceAll(s, "Country{", "{")
-	s = strings.ReplaceAll(s, ":Subdivision{", ":{")
-	return s
+type postalCodeRange struct {
+	from string
+	to   string
+	code string
 }
 
-func alpha2(countries []countries.Country) []string {
-	result := make([]string, len(countries))
-	for i := range countries {
-		result[i] = countries[i].Alpha2
+// postalCodeRanges returns the postal code ranges of the country, parsed from
+// the "from-to" or "prefix" strings of the data file, ordered from the most
+// specific (longest) to the least specific. It returns an error if a range
+// refers to an unknown subdivision, is malformed or overlaps another range.
+func postalCodeRanges(c countries.Country, data map[string][]string) ([]postalCodeRange, error) {
+	var result []postalCodeRange
+	for code, ranges := range data {
+		if _, found := c.Subdivisions[code]; !found {
+			return nil, fmt.Errorf("%s-%s: unknown subdivision", c.Alpha2, code)
+		}
+		for _, s := range ranges {
+			bounds := strings.SplitN(s, "-", 2)
+			from, to := bounds[0], bounds[0]
+			if len(bounds) == 2 {
+				to = bounds[1]
+			}
+			if from == "" || len(from) != len(to) || from > to {
+				return nil, fmt.Errorf("%s-%s: invalid postal code range %q", c.Alpha2, code, s)
+			}
+			result = append(result, postalCodeRange{from: from, to: to, code: code})
+		}
 	}
-	return result
+	sort.Slice(result, func(i, j int) bool {
+		if len(result[i].from) != len(result[j].from) {
+			return len(result[i].from) > len(result[j].from)
+		}
+		return result[i].from < result[j].from
+	})
+	for i := 1; i < len(result); i++ {
+		prev, r := result[i-1], result[i]
+		if len(prev.from) == len(r.from) && r.from <= prev.to {
+			return nil, fmt.Errorf("%s: overlapping postal code ranges %s-%s and %s-%s", c.Alpha2, prev.from, prev.to, r.from, r.to)
+		}
+	}
+	return result, nil
 }
 
-func regions(countries []countries.Country) []string {
-	var result []string
-	set := make(map[string]struct{})
-	for _, c := range countries {
-		if c.Region != "" {
-			set[c.Region] = struct{}{}
+// postalCodeExample returns an example postal code matching format and a
+// human-readable hint of format. The hint lists the variants of format, most
+// specific first, where a variant is an alternative of format with or without
+// each of its optional parts: variants made of digits only are described
+// together by their lengths, like "5 digits", "4 to 5 digits" or "3, 5 or 6
+// digits", the others by a mask where 9 stands for a digit, A for a letter and
+// * for a letter or a digit, like "A9A 9A9".
+func postalCodeExample(format string) (example, hint string, err error) {
+	re, err := syntax.Parse(format, syntax.Perl)
+	if err != nil {
+		return "", "", err
+	}
+	var sample postalCodeSampler
+	example = sample.write(re)
+
+	variants := postalCodeVariants(re)
+	sort.SliceStable(variants, func(i, j int) bool {
+		return classWeight(variants[i]) > classWeight(variants[j])
+	})
+	var hints []string
+	lengths := make(map[int]bool)
+	for _, variant := range variants {
+		if min, max, digits := digitsLength(variant); digits && classWeight(variant) > 0 {
+			for n := min; n <= max; n++ {
+				lengths[n] = true
+			}
+			continue
+		}
+		for _, mask := range postalCodeMasks(variant, classWeight(variant) > 0, false) {
+			hints = appendUnique(hints, mask)
 		}
 	}
-	for r := range set {
-		result = append(result, r)
+	if len(lengths) > 0 {
+		hints = append(hints, digitsHint(lengths))
 	}
-	sort.Slice(result, func(i, j int) bool {
-		return result[i] < result[j]
-	})
-	return result
+	hint = hints[len(hints)-1]
+	if len(hints) > 1 {
+		hint = strings.Join(hints[:len(hints)-1], ", ") + " or " + hint
+	}
+	return example, hint, nil
 }
 
-func subregions(countries []countries.Country) []string {
-	var result []string
-	set := make(map[string]struct{})
-	for _, c := range countries {
-		if c.Subregion != "" {
-			set[c.Subregion] = struct{}{}
+// postalCodeVariants returns the alternatives of re, each expanded in the
+// variants with and without its optional parts, see optionalVariants.
+func postalCodeVariants(re *syntax.Regexp) []*syntax.Regexp {
+	if re.Op != syntax.OpAlternate {
+		return optionalVariants(re)
+	}
+	var variants []*syntax.Regexp
+	for _, sub := range re.Sub {
+		variants = append(variants, optionalVariants(sub)...)
+	}
+	return variants
+}
+
+// optionalVariants returns the variants of re with and without each of its
+// optional parts, except the optional separators. Alternations are not
+// expanded.
+func optionalVariants(re *syntax.Regexp) []*syntax.Regexp {
+	switch re.Op {
+	case syntax.OpCapture:
+		return optionalVariants(re.Sub[0])
+	case syntax.OpQuest:
+		if isSeparator(re.Sub[0]) {
+			return []*syntax.Regexp{re}
+		}
+		return append(optionalVariants(re.Sub[0]), &syntax.Regexp{Op: syntax.OpEmptyMatch})
+	case syntax.OpConcat:
+		variants := [][]*syntax.Regexp{nil}
+		for _, sub := range re.Sub {
+			var next [][]*syntax.Regexp
+			for _, prefix := range variants {
+				for _, variant := range optionalVariants(sub) {
+					next = append(next, append(append([]*syntax.Regexp(nil), prefix...), variant))
+				}
+			}
+			variants = next
 		}
+		result := make([]*syntax.Regexp, len(variants))
+		for i, subs := range variants {
+			result[i] = &syntax.Regexp{Op: syntax.OpConcat, Sub: subs}
+		}
+		return result
 	}
-	for r := range set {
-		result = append(result, r)
+	return []*syntax.Regexp{re}
+}
+
+// digitsHint describes the lengths of postal codes made of digits only, as a
+// range if they are contiguous, like "4 to 5 digits", or as a list otherwise,
+// like "3, 5 or 6 digits".
+func digitsHint(lengths map[int]bool) string {
+	var sorted []int
+	for n := range lengths {
+		sorted = append(sorted, n)
 	}
-	sort.Slice(result, func(i, j int) bool {
-		return result[i] < result[j]
-	})
+	sort.Ints(sorted)
+	min, max := sorted[0], sorted[len(sorted)-1]
+	switch {
+	case min == max:
+		return fmt.Sprintf("%d digits", min)
+	case max-min+1 == len(sorted):
+		return fmt.Sprintf("%d to %d digits", min, max)
+	}
+	list := make([]string, len(sorted)-1)
+	for i, n := range sorted[:len(sorted)-1] {
+		list[i] = strconv.Itoa(n)
+	}
+	return fmt.Sprintf("%s or %d digits", strings.Join(list, ", "), max)
+}
+
+// postalCodeSampler writes strings matching a regular expression. Digits and
+// letters are taken in sequence, so examples look like "12345" rather than
+// "00000".
+type postalCodeSampler struct {
+	digits  int
+	letters int
+}
+
+// write returns a string matching re. Optional parts are skipped, except
+// optional spaces and dashes that separate the groups of a postal code, and
+// repetitions are written the maximum number of times.
+func (s *postalCodeSampler) write(re *syntax.Regexp) string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return string(re.Rune)
+	case syntax.OpCharClass:
+		return s.class(re.Rune)
+	case syntax.OpCapture, syntax.OpPlus:
+		return s.write(re.Sub[0])
+	case syntax.OpQuest:
+		if isSeparator(re.Sub[0]) {
+			return string(re.Sub[0].Rune)
+		}
+		return ""
+	case syntax.OpRepeat:
+		var b strings.Builder
+		for i := 0; i < repeatCount(re); i++ {
+			b.WriteString(s.write(re.Sub[0]))
+		}
+		return b.String()
+	case syntax.OpConcat:
+		var b strings.Builder
+		for _, sub := range re.Sub {
+			b.WriteString(s.write(sub))
+		}
+		return b.String()
+	case syntax.OpAlternate:
+		best := re.Sub[0]
+		for _, sub := range re.Sub[1:] {
+			if classWeight(sub) > classWeight(best) {
+				best = sub
+			}
+		}
+		return s.write(best)
+	}
+	return ""
+}
+
+// class returns a character of the class defined by ranges, a list of rune
+// pairs. Classes of letters and digits start with a letter, so that examples
+// look like "A12 3456".
+func (s *postalCodeSampler) class(ranges []rune) string {
+	hasDigits, hasLetters := classKinds(ranges)
+	switch {
+	case hasLetters && (!hasDigits || s.letters == 0):
+		s.letters++
+		for i := 0; i < 26; i++ {
+			if r := rune('A' + (s.letters-1+i)%26); inRanges(ranges, r) {
+				return string(r)
+			}
+		}
+	case hasDigits:
+		s.digits++
+		for i := 0; i < 10; i++ {
+			if r := rune('0' + (s.digits+i)%10); inRanges(ranges, r) {
+				return string(r)
+			}
+		}
+	}
+	return string(ranges[0])
+}
+
+// postalCodeMasks returns the masks of the strings matched by re, one for each
+// combination of its alternatives. Characters matched by a class are written
+// as 9 for digits, A for letters and * for letters or digits; literal digits
+// are written as 9 too if maskDigits is true, and literal letters as A if
+// maskLetters is true. Alternations of more than maxLiteralAlternatives
+// fixed strings, like the area codes of British postal codes, are masked.
+func postalCodeMasks(re *syntax.Regexp, maskDigits, maskLetters bool) []string {
+	switch re.Op {
+	case syntax.OpLiteral:
+		return []string{strings.Map(func(r rune) rune {
+			switch {
+			case maskDigits && r >= '0' && r <= '9':
+				return '9'
+			case maskLetters && r >= 'A' && r <= 'Z':
+				return 'A'
+			}
+			return r
+		}, string(re.Rune))}
+	case syntax.OpCharClass:
+		switch hasDigits, hasLetters := classKinds(re.Rune); {
+		case hasDigits && hasLetters:
+			return []string{"*"}
+		case hasDigits:
+			return []string{"9"}
+		case hasLetters:
+			return []string{"A"}
+		case inRanges(re.Rune, '-'):
+			return []string{"-"}
+		}
+		return []string{string(re.Rune[0])}
+	case syntax.OpCapture, syntax.OpPlus:
+		return postalCodeMasks(re.Sub[0], maskDigits, maskLetters)
+	case syntax.OpQuest:
+		if isSeparator(re.Sub[0]) {
+			return []string{string(re.Sub[0].Rune)}
+		}
+		return []string{""}
+	case syntax.OpRepeat:
+		var masks []string
+		for _, mask := range postalCodeMasks(re.Sub[0], maskDigits, maskLetters) {
+			masks = appendUnique(masks, strings.Repeat(mask, repeatCount(re)))
+		}
+		return masks
+	case syntax.OpConcat:
+		masks := []string{""}
+		for _, sub := range re.Sub {
+			var next []string
+			for _, prefix := range masks {
+				for _, mask := range postalCodeMasks(sub, maskDigits, maskLetters) {
+					next = appendUnique(next, prefix+mask)
+				}
+			}
+			masks = next
+		}
+		return masks
+	case syntax.OpAlternate:
+		if n := fixedStrings(re); n > maxLiteralAlternatives {
+			maskLetters = true
+		}
+		var masks []string
+		for _, sub := range re.Sub {
+			for _, mask := range postalCodeMasks(sub, maskDigits, maskLetters) {
+				masks = appendUnique(masks, mask)
+			}
+		}
+		return masks
+	}
+	return []string{""}
+}
+
+// maxLiteralAlternatives is the maximum number of alternated fixed strings
+// listed in a postal code hint.
+const maxLiteralAlternatives = 3
+
+// fixedStrings returns the number of strings matched by re if re is made of
+// literals and classes only, or -1 otherwise.
+func fixedStrings(re *syntax.Regexp) int {
+	switch re.Op {
+	case syntax.OpLiteral, syntax.OpEmptyMatch:
+		return 1
+	case syntax.OpCharClass:
+		n := 0
+		for i := 0; i < len(re.Rune); i += 2 {
+			n += int(re.Rune[i+1]-re.Rune[i]) + 1
+		}
+		return n
+	case syntax.OpCapture:
+		return fixedStrings(re.Sub[0])
+	case syntax.OpQuest:
+		if n := fixedStrings(re.Sub[0]); n >= 0 {
+			return n + 1
+		}
+	case syntax.OpConcat, syntax.OpAlternate:
+		n := 1
+		if re.Op == syntax.OpAlternate {
+			n = 0
+		}
+		for _, sub := range re.Sub {
+			m := fixedStrings(sub)
+			if m < 0 {
+				return -1
+			}
+			if re.Op == syntax.OpAlternate {
+				n += m
+			} else {
+				n *= m
+			}
+		}
+		return n
+	}
+	return -1
+}
+
+// repeatCount returns the number of repetitions written for re: the maximum,
+// or the minimum if re is unbounded.
+func repeatCount(re *syntax.Regexp) int {
+	if re.Max < 0 {
+		return re.Min
+	}
+	return re.Max
+}
+
+// classKinds reports whether the class ranges contain digits and letters.
+func classKinds(ranges []rune) (hasDigits, hasLetters bool) {
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		hasDigits = hasDigits || (lo <= '9' && hi >= '0')
+		hasLetters = hasLetters || (lo <= 'Z' && hi >= 'A')
+	}
+	return hasDigits, hasLetters
+}
+
+// inRanges reports whether r is in the class ranges.
+func inRanges(ranges []rune, r rune) bool {
+	for i := 0; i < len(ranges); i += 2 {
+		if ranges[i] <= r && r <= ranges[i+1] {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUnique(values []string, s string) []string {
+	for _, v := range values {
+		if v == s {
+			return values
+		}
+	}
+	return append(values, s)
+}
+
+// isSeparator reports whether re is a space or a dash.
+func isSeparator(re *syntax.Regexp) bool {
+	return re.Op == syntax.OpLiteral && (string(re.Rune) == " " || string(re.Rune) == "-")
+}
+
+// classWeight returns the minimum number of characters matched by character
+// classes in re. It is used to choose the most general alternative.
+func classWeight(re *syntax.Regexp) int {
+	switch re.Op {
+	case syntax.OpCharClass:
+		return 1
+	case syntax.OpRepeat:
+		return re.Min * classWeight(re.Sub[0])
+	case syntax.OpCapture, syntax.OpPlus:
+		return classWeight(re.Sub[0])
+	case syntax.OpConcat:
+		weight := 0
+		for _, sub := range re.Sub {
+			weight += classWeight(sub)
+		}
+		return weight
+	case syntax.OpAlternate:
+		weight := 0
+		for _, sub := range re.Sub {
+			if w := classWeight(sub); w > weight {
+				weight = w
+			}
+		}
+		return weight
+	}
+	return 0
+}
+
+// digitsLength returns the minimum and the maximum length of the strings
+// matched by re and whether re matches only digits.
+func digitsLength(re *syntax.Regexp) (min, max int, digits bool) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if r < '0' || r > '9' {
+				return 0, 0, false
+			}
+		}
+		return len(re.Rune), len(re.Rune), true
+	case syntax.OpCharClass:
+		for _, r := range re.Rune {
+			if r < '0' || r > '9' {
+				return 0, 0, false
+			}
+		}
+		return 1, 1, true
+	case syntax.OpCapture:
+		return digitsLength(re.Sub[0])
+	case syntax.OpEmptyMatch:
+		return 0, 0, true
+	case syntax.OpQuest:
+		// Optional separators are part of the hint, as in write.
+		if isSeparator(re.Sub[0]) {
+			return 0, 0, false
+		}
+		_, max, digits = digitsLength(re.Sub[0])
+		return 0, max, digits
+	case syntax.OpRepeat:
+		if re.Max < 0 {
+			return 0, 0, false
+		}
+		min, max, digits = digitsLength(re.Sub[0])
+		return re.Min * min, re.Max * max, digits
+	case syntax.OpConcat:
+		digits = true
+		for _, sub := range re.Sub {
+			subMin, subMax, subDigits := digitsLength(sub)
+			min, max, digits = min+subMin, max+subMax, digits && subDigits
+		}
+		return min, max, digits
+	case syntax.OpAlternate:
+		min, max, digits = digitsLength(re.Sub[0])
+		for _, sub := range re.Sub[1:] {
+			subMin, subMax, subDigits := digitsLength(sub)
+			if subMin < min {
+				min = subMin
+			}
+			if subMax > max {
+				max = subMax
+			}
+			digits = digits && subDigits
+		}
+		return min, max, digits
+	}
+	return 0, 0, false
+}
+
+// loadRequiredFields loads the Address fields required by the countries, by
+// country alpha2 code.
+func loadRequiredFields(requiredFieldsPath string, out map[string][]string) error {
+	buf, err := os.ReadFile(requiredFieldsPath)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// loadFieldLabels loads the translated labels of the Address fields. It
+// returns an error if a label refers to an unknown field.
+func loadFieldLabels(fieldLabelsPath string, out map[string]map[string]string) error {
+	buf, err := os.ReadFile(fieldLabelsPath)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	t := reflect.TypeOf(countries.Address{})
+	for field := range out {
+		if _, found := t.FieldByName(field); !found {
+			return fmt.Errorf("%s: unknown address field %q", fieldLabelsPath, field)
+		}
+	}
+	return nil
+}
+
+// checkRequiredFields returns an error if fields contains names that are not
+// Address fields or requires the postal code of a country without postal codes
+// or the region of a country without subdivisions.
+func checkRequiredFields(c countries.Country, fields []string) error {
+	t := reflect.TypeOf(countries.Address{})
+	for _, field := range fields {
+		if _, found := t.FieldByName(field); !found {
+			return fmt.Errorf("unknown address field %q", field)
+		}
+		if field == "PostalCode" && !c.HasPostalCode() {
+			return fmt.Errorf("postal code required without postal codes")
+		}
+		if field == "Region" && len(c.Subdivisions) == 0 {
+			return fmt.Errorf("region required without subdivisions")
+		}
+	}
+	return nil
+}
+
+// loadAddressTransforms loads the transforms of the address format fields, by
+// country alpha2 code.
+func loadAddressTransforms(addressTransformsPath string, out map[string]map[string][]string) error {
+	buf, err := os.ReadFile(addressTransformsPath)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// checkAddressTransforms returns an error if transforms refers to fields that
+// are not in the country address format or contains unknown transforms. The
+// region_short placeholder is the region with the short transform, so its
+// transforms must be listed under region.
+func checkAddressTransforms(c countries.Country, transforms map[string][]string) error {
+	format, err := countries.ParseAddressTemplate(c.AddressFormat)
+	if err != nil {
+		return err
+	}
+	for field, names := range transforms {
+		if field == "region_short" {
+			return fmt.Errorf("transforms of placeholder %q must be listed under \"region\"", field)
+		}
+		if !format.Has(field) {
+			return fmt.Errorf("address format without placeholder %q", field)
+		}
+		_, err = countries.ParseAddressTemplate("{{" + strings.Join(append([]string{field}, names...), "|") + "}}")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// numberingPlan is the numbering plan of a country in the phone numbering data
+// file.
+type numberingPlan struct {
+	NationalNumberLengths []string          `yaml:"national_number_lengths"`
+	DestinationCodes      []destinationCode `yaml:"destination_codes"`
+	AreaCodes             []string          `yaml:"area_codes"`
+}
+
+type destinationCode struct {
+	Prefix string `yaml:"prefix"`
+	Length int    `yaml:"length"`
+	Groups []int  `yaml:"groups"`
+}
+
+func loadNumberingPlans(numberingPath string, out map[string]numberingPlan) error {
+	buf, err := os.ReadFile(numberingPath)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	err = checkUnknownKeys(numberingPath, buf, reflect.TypeOf(numberingPlan{}))
+	if err != nil {
+		return err
+	}
+	for alpha2, plan := range out {
+		sort.SliceStable(plan.DestinationCodes, func(i, j int) bool {
+			return len(plan.DestinationCodes[i].Prefix) > len(plan.DestinationCodes[j].Prefix)
+		})
+		out[alpha2] = plan
+	}
+	return nil
+}
+
+// nationalNumberLengths returns the lengths of the national numbers of the
+// numbering plan, with the ranges expanded, and checks its national
+// destination codes. It returns an error if a length or a code is invalid.
+func nationalNumberLengths(plan numberingPlan) ([]int, error) {
+	var lengths []int
+	for _, s := range plan.NationalNumberLengths {
+		from, to, err := lengthRange(s)
+		if err != nil {
+			return nil, err
+		}
+		for l := from; l <= to; l++ {
+			lengths = append(lengths, l)
+		}
+	}
+	for _, d := range plan.DestinationCodes {
+		if d.Length <= 0 || len(d.Prefix) > d.Length || strings.Trim(d.Prefix, "0123456789") != "" {
+			return nil, fmt.Errorf("invalid national destination code %q", d.Prefix)
+		}
+	}
+	return lengths, nil
+}
+
+// lengthRange parses a length, like "9", or a range of lengths, like "6-11".
+func lengthRange(s string) (from, to int, err error) {
+	parts := strings.SplitN(s, "-", 2)
+	from, err = strconv.Atoi(parts[0])
+	to = from
+	if err == nil && len(parts) == 2 {
+		to, err = strconv.Atoi(parts[1])
+	}
+	if err != nil || from <= 0 || from > to {
+		return 0, 0, fmt.Errorf("invalid national number length %q", s)
+	}
+	return from, to, nil
+}
+
+// nanpAreaCodes returns the countries of the NANP area codes of the numbering
+// plans. It returns an error if an area code is not made of three digits,
+// belongs to a country outside the NANP or is the NANP prefix of another
+// country.
+func nanpAreaCodes(all []countries.Country, plans map[string]numberingPlan) (map[string][]string, error) {
+	prefixes := make(map[string]string)
+	for _, c := range all {
+		if c.CountryCode == "1" && strings.HasPrefix(c.NANPPrefix, "1") {
+			prefixes[c.NANPPrefix[1:]] = c.Alpha2
+		}
+	}
+	result := make(map[string][]string)
+	for _, c := range all {
+		for _, code := range plans[c.Alpha2].AreaCodes {
+			if c.CountryCode != "1" {
+				return nil, fmt.Errorf("%s: area codes outside the NANP", c.Alpha2)
+			}
+			if len(code) != 3 || strings.Trim(code, "0123456789") != "" {
+				return nil, fmt.Errorf("%s: invalid area code %q", c.Alpha2, code)
+			}
+			if alpha2, found := prefixes[code]; found && alpha2 != c.Alpha2 {
+				return nil, fmt.Errorf("%s: area code %s is the NANP prefix of %s", c.Alpha2, code, alpha2)
+			}
+			result[code] = append(result[code], c.Alpha2)
+		}
+	}
+	return result, nil
+}
+
+// numberingSource returns the source of the phone package data: the lengths of
+// the national numbers and the national destination codes of the numbering
+// plans.
+func numberingSource(all []countries.Country, plans map[string]numberingPlan) ([]byte, error) {
+	known := make(map[string]struct{})
+	lengths := make(map[string][]int)
+	for _, c := range all {
+		known[c.Alpha2] = struct{}{}
+		l, err := nationalNumberLengths(plans[c.Alpha2])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", c.Alpha2, err)
+		}
+		lengths[c.Alpha2] = l
+	}
+	for alpha2 := range plans {
+		if _, found := known[alpha2]; !found {
+			return nil, fmt.Errorf("unknown country %s in numbering plans", alpha2)
+		}
+	}
+
+	g := Generator{}
+	g.Printf("// Code generated by \"go run generator/main.go %s\"; DO NOT EDIT.\n", strings.Join(os.Args[1:], " "))
+	g.Printf("\n")
+	g.Printf("package phone\n")
+	g.Printf("\n")
+	g.Printf("// nationalNumberLengths maps country alpha2 codes to the lengths of their\n")
+	g.Printf("// national numbers, when the numbering plan replaces the ones of the country.\n")
+	g.Printf("var nationalNumberLengths = map[string][]int{\n")
+	for _, c := range all {
+		if len(lengths[c.Alpha2]) > 0 {
+			g.Printf("  %q: %#v,\n", c.Alpha2, lengths[c.Alpha2])
+		}
+	}
+	g.Printf("}\n")
+	g.Printf("\n")
+	g.Printf("// destinationCodes maps country alpha2 codes to their national destination\n")
+	g.Printf("// codes, longest prefix first.\n")
+	g.Printf("var destinationCodes = map[string][]destinationCode{\n")
+	for _, c := range all {
+		plan := plans[c.Alpha2]
+		if len(plan.DestinationCodes) == 0 {
+			continue
+		}
+		g.Printf("  %q: {\n", c.Alpha2)
+		for _, d := range plan.DestinationCodes {
+			groups := "nil"
+			if len(d.Groups) > 0 {
+				groups = fmt.Sprintf("%#v", d.Groups)
+			}
+			g.Printf("    {%q, %d, %s},\n", d.Prefix, d.Length, groups)
+		}
+		g.Printf("  },\n")
+	}
+	g.Printf("}\n")
+	return g.format(), nil
+}
+
+func loadCurrencies(currenciesPath string, out map[string]currency.Currency) error {
+	buf, err := os.ReadFile(currenciesPath)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	err = checkUnknownKeys(currenciesPath, buf, reflect.TypeOf(currency.Currency{}))
+	if err != nil {
+		return err
+	}
+	numerics := make(map[string]string)
+	for code, c := range out {
+		if len(c.Numeric) != 3 || c.MinorUnits < 0 || c.MinorUnits > 4 || c.Name == "" {
+			return fmt.Errorf("%s: invalid currency %s", currenciesPath, code)
+		}
+		if other, found := numerics[c.Numeric]; found {
+			return fmt.Errorf("%s: currencies %s and %s have the same numeric code %s", currenciesPath, other, code, c.Numeric)
+		}
+		numerics[c.Numeric] = code
+		c.Code = code
+		if c.Translations == nil {
+			c.Translations = make(map[string]string)
+		}
+		c.Translations["en"] = c.Name
+		out[code] = c
+	}
+	return nil
+}
+
+func loadMoneyFormats(moneyFormatsPath string, out map[string]currency.MoneyFormat) error {
+	buf, err := os.ReadFile(moneyFormatsPath)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	err = checkUnknownKeys(moneyFormatsPath, buf, reflect.TypeOf(currency.MoneyFormat{}))
+	if err != nil {
+		return err
+	}
+	for locale, f := range out {
+		if f.Decimal == "" || strings.Count(f.Pattern, "#") != 1 || strings.Count(f.Pattern, "¤") != 1 {
+			return fmt.Errorf("%s: invalid money format %s", moneyFormatsPath, locale)
+		}
+		for _, size := range f.Grouping {
+			if size <= 0 {
+				return fmt.Errorf("%s: invalid grouping of money format %s", moneyFormatsPath, locale)
+			}
+		}
+	}
+	if _, found := out["en"]; !found {
+		return fmt.Errorf("%s: missing money format en", moneyFormatsPath)
+	}
+	return nil
+}
+
+// currenciesSource returns the source of the currency package data: the
+// currencies, their indexes by code and numeric code, the countries using
+// them and the money formats. It returns an error if a country uses an
+// unknown currency.
+func currenciesSource(all []countries.Country, currencies map[string]currency.Currency, moneyFormats map[string]currency.MoneyFormat) ([]byte, error) {
+	countriesByCode := make(map[string][]string)
+	for _, c := range all {
+		for _, code := range []string{c.CurrencyCode, c.AltCurrency} {
+			if code == "" {
+				continue
+			}
+			if _, found := currencies[code]; !found {
+				return nil, fmt.Errorf("%s: unknown currency %s", c.Alpha2, code)
+			}
+			countriesByCode[code] = append(countriesByCode[code], c.Alpha2)
+		}
+	}
+	codes := make([]string, 0, len(currencies))
+	for code := range currencies {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	g := Generator{}
+	g.Printf("// Code generated by \"go run generator/main.go %s\"; DO NOT EDIT.\n", strings.Join(os.Args[1:], " "))
+	g.Printf("\n")
+	g.Printf("package currency\n")
+	g.Printf("\n")
+	g.Printf("// All is a slice with all currencies ordered by code.\n")
+	g.Printf("var All = []Currency{\n")
+	for _, code := range codes {
+		s := fmt.Sprintf("%#v", currencies[code])
+		s = strings.Replace(s, "currency.Currency{", "{", 1)
+		g.Printf("  %s,\n", s)
+	}
+	g.Printf("}\n")
+	g.Printf("\n")
+	g.Printf("// byCode maps currency codes to their index in All.\n")
+	g.Printf("var byCode = map[string]int{\n")
+	for i, code := range codes {
+		g.Printf("  %q: %d,\n", code, i)
+	}
+	g.Printf("}\n")
+	g.Printf("\n")
+	g.Printf("// byNumeric maps currency numeric codes to their index in All.\n")
+	g.Printf("var byNumeric = map[string]int{\n")
+	for i, code := range codes {
+		g.Printf("  %q: %d,\n", currencies[code].Numeric, i)
+	}
+	g.Printf("}\n")
+	g.Printf("\n")
+	g.Printf("// countriesByCode maps currency codes to the alpha2 codes of the countries\n")
+	g.Printf("// using them.\n")
+	g.Printf("var countriesByCode = map[string][]string{\n")
+	for _, code := range codes {
+		if len(countriesByCode[code]) > 0 {
+			g.Printf("  %q: %#v,\n", code, countriesByCode[code])
+		}
+	}
+	g.Printf("}\n")
+	g.Printf("\n")
+	g.Printf("// moneyFormats maps locales to their money format.\n")
+	g.Printf("var moneyFormats = map[string]MoneyFormat{\n")
+	locales := make([]string, 0, len(moneyFormats))
+	for locale := range moneyFormats {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		s := fmt.Sprintf("%#v", moneyFormats[locale])
+		s = strings.Replace(s, "currency.MoneyFormat{", "{", 1)
+		g.Printf("  %q: %s,\n", locale, s)
+	}
+	g.Printf("}\n")
+	return g.format(), nil
+}
+
+func loadTranslations(translationsPath string, out map[string]map[string]string) error {
+	files, err := os.ReadDir(translationsPath)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		translations := make(map[string]string)
+		path := filepath.Join(translationsPath, file.Name())
+		buf, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		err = yaml.Unmarshal(buf, &translations)
+		if err != nil {
+			return err
+		}
+		locale := filenameToLocale(file.Name())
+		out[locale] = translations
+	}
+	return nil
+}
+
+func loadCapitals(capitalsPath string, out map[string]string) error {
+	buf, err := os.ReadFile(capitalsPath)
+	if err != nil {
+		return err
+	}
+	err = yaml.Unmarshal(buf, &out)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// CSV file link: https://timezonedb.com/files/timezonedb.csv.zip
+func loadTimezones(timezonesPath string, out map[string][]string) error {
+	f, err := os.Open(timezonesPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	csvReader := csv.NewReader(f)
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return err
+	}
+	for _, row := range records {
+		out[row[1]] = append(out[row[1]], row[2])
+	}
+	return nil
+}
+
+func countryToCodeString(c countries.Country) string {
+	s := fmt.Sprintf("%#v", c)
+	s = strings.ReplaceAll(s, "countries.", "")
+	s = strings.ReplaceAll(s, "Country{", "{")
+	s = strings.ReplaceAll(s, ":Subdivision{", ":{")
+	return s
+}
+
+func alpha2(countries []countries.Country) []string {
+	result := make([]string, len(countries))
+	for i := range countries {
+		result[i] = countries[i].Alpha2
+	}
+	return result
+}
+
+func regions(countries []countries.Country) []string {
+	var result []string
+	set := make(map[string]struct{})
+	for _, c := range countries {
+		if c.Region != "" {
+			set[c.Region] = struct{}{}
+		}
+	}
+	for r := range set {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+func subregions(countries []countries.Country) []string {
+	var result []string
+	set := make(map[string]struct{})
+	for _, c := range countries {
+		if c.Subregion != "" {
+			set[c.Subregion] = struct{}{}
+		}
+	}
+	for r := range set {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
 	return result
 }
 
+func subdivisionTypes(all []countries.Country) []countries.SubdivisionType {
+	var result []countries.SubdivisionType
+	set := make(map[countries.SubdivisionType]struct{})
+	for _, c := range all {
+		for _, s := range c.Subdivisions {
+			if s.Type != "" {
+				set[s.Kind()] = struct{}{}
+			}
+		}
+	}
+	for t := range set {
+		result = append(result, t)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// subdivisionTypeConstName returns the name of the constant for the
+// subdivision type t, like SubdivisionTypeTwoTierCounty for "two-tier_county".
+func subdivisionTypeConstName(t countries.SubdivisionType) string {
+	words := strings.FieldsFunc(string(t), func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return "SubdivisionType" + strings.Join(words, "")
+}
+
+type nameEntry struct {
+	country  int
+	name     string
+	locale   string
+	priority int
+}
+
+// nameIndex returns all country names, grouped by normalized name. ISO names
+// come first, followed by unofficial names and translations.
+func nameIndex(all []countries.Country) map[string][]nameEntry {
+	index := make(map[string][]nameEntry)
+	seen := make(map[nameEntry]struct{})
+	add := func(country int, name, locale string, priority int) {
+		key := countries.NormalizeName(name)
+		if key == "" {
+			return
+		}
+		e := nameEntry{country: country, name: name, locale: locale}
+		if _, found := seen[e]; found {
+			return
+		}
+		seen[e] = struct{}{}
+		e.priority = priority
+		index[key] = append(index[key], e)
+	}
+	for i, c := range all {
+		add(i, c.ISOShortName, "", 0)
+		add(i, c.ISOLongName, "", 1)
+		for _, name := range c.UnofficialNames {
+			add(i, name, "", 2)
+		}
+		locales := make([]string, 0, len(c.Translations))
+		for locale := range c.Translations {
+			locales = append(locales, locale)
+		}
+		sort.Strings(locales)
+		for _, locale := range locales {
+			add(i, c.Translations[locale], locale, 3)
+		}
+	}
+	for _, entries := range index {
+		sort.SliceStable(entries, func(i, j int) bool {
+			if entries[i].priority != entries[j].priority {
+				return entries[i].priority < entries[j].priority
+			}
+			return entries[i].country < entries[j].country
+		})
+	}
+	return index
+}
+
 func filenameToCountryAlpha2(filename string) string {
 	return strings.ReplaceAll(filename, ".yaml", "")
 }
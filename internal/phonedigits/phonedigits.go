@@ -0,0 +1,27 @@
+// Package phonedigits extracts the digits of phone numbers written with the
+// usual punctuation.
+package phonedigits
+
+import "strings"
+
+// Extract returns the digits of the phone number s and whether s starts with
+// "+". The number can contain spaces, dashes, dots, slashes and parentheses;
+// if it contains other characters or no digits, ok is false.
+func Extract(s string) (digits string, plus bool, ok bool) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "+") {
+		plus = true
+		s = s[1:]
+	}
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '/' || r == '(' || r == ')':
+		default:
+			return "", false, false
+		}
+	}
+	return b.String(), plus, b.Len() > 0
+}
@@ -0,0 +1,172 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// nameIndexEntry is an entry of the generated name index. Country is the index
+// of the country in All, Name the original (not normalized) name and Locale the
+// locale of the name or an empty string for ISO and unofficial names.
+type nameIndexEntry struct {
+	Country int
+	Name    string
+	Locale  string
+}
+
+// subdivisionNameIndexEntry is an entry of the subdivision name index. Code is
+// the subdivision code, Name the original (not normalized) name and Locale the
+// locale of the name or an empty string for official and unofficial names.
+type subdivisionNameIndexEntry struct {
+	Code   string
+	Name   string
+	Locale string
+}
+
+// subdivisionNameIndex maps country alpha2 codes to the normalized names, in
+// all locales, of the country's subdivisions. Unlike nameIndex it is built on
+// first use, so the subdivision translations are not duplicated in the
+// generated code.
+var (
+	subdivisionNameIndex     map[string]map[string][]subdivisionNameIndexEntry
+	subdivisionNameIndexOnce sync.Once
+)
+
+// subdivisionNames returns the subdivision name index of the country with
+// alpha2 code. For each normalized name, subdivision names come first,
+// followed by unofficial names and translations.
+func subdivisionNames(alpha2 string) map[string][]subdivisionNameIndexEntry {
+	subdivisionNameIndexOnce.Do(buildSubdivisionNameIndex)
+	return subdivisionNameIndex[alpha2]
+}
+
+func buildSubdivisionNameIndex() {
+	type entry struct {
+		subdivisionNameIndexEntry
+		priority int
+	}
+	subdivisionNameIndex = make(map[string]map[string][]subdivisionNameIndexEntry)
+	for _, c := range All {
+		entries := make(map[string][]entry)
+		seen := make(map[subdivisionNameIndexEntry]struct{})
+		add := func(code, name, locale string, priority int) {
+			key := NormalizeName(name)
+			if key == "" {
+				return
+			}
+			e := subdivisionNameIndexEntry{Code: code, Name: name, Locale: locale}
+			if _, found := seen[e]; found {
+				return
+			}
+			seen[e] = struct{}{}
+			entries[key] = append(entries[key], entry{e, priority})
+		}
+		for code, s := range c.Subdivisions {
+			add(code, s.Name, "", 0)
+			for _, name := range s.UnofficialNames {
+				add(code, name, "", 1)
+			}
+			for locale, name := range s.Translations {
+				add(code, name, locale, 2)
+			}
+		}
+		index := make(map[string][]subdivisionNameIndexEntry, len(entries))
+		for key, list := range entries {
+			sort.Slice(list, func(i, j int) bool {
+				if list[i].priority != list[j].priority {
+					return list[i].priority < list[j].priority
+				}
+				if list[i].Code != list[j].Code {
+					return list[i].Code < list[j].Code
+				}
+				return list[i].Locale < list[j].Locale
+			})
+			index[key] = make([]subdivisionNameIndexEntry, len(list))
+			for i, e := range list {
+				index[key][i] = e.subdivisionNameIndexEntry
+			}
+		}
+		subdivisionNameIndex[c.Alpha2] = index
+	}
+}
+
+// foldedRunes maps letters with diacritics to their base letters.
+var foldedRunes = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae",
+	'ç': "c", 'ć': "c", 'ĉ': "c", 'ċ': "c", 'č': "c",
+	'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ĕ': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g",
+	'ĥ': "h", 'ħ': "h",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ĩ': "i", 'ī': "i", 'ĭ': "i", 'į': "i", 'ı': "i",
+	'ĵ': "j",
+	'ķ': "k",
+	'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ŀ': "l", 'ł': "l",
+	'ñ': "n", 'ń': "n", 'ņ': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ŏ': "o", 'ő': "o",
+	'œ': "oe",
+	'ŕ': "r", 'ŗ': "r", 'ř': "r",
+	'ś': "s", 'ŝ': "s", 'ş': "s", 'š': "s", 'ș': "s",
+	'ß': "ss",
+	'ţ': "t", 'ť': "t", 'ŧ': "t", 'ț': "t",
+	'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ũ': "u", 'ū': "u", 'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ŵ': "w",
+	'ý': "y", 'ÿ': "y", 'ŷ': "y",
+	'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// NormalizeName returns name in the form used by the name indexes: lower case,
+// without diacritics and with punctuation and repeated spaces collapsed into a
+// single space. Two names that differ only by case, accents or punctuation have
+// the same normalized form.
+func NormalizeName(name string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= '\u0300' && r <= '\u036f':
+			// Combining diacritical marks
+			continue
+		case unicode.IsSpace(r) || unicode.IsPunct(r):
+			space = b.Len() > 0
+			continue
+		}
+		if space {
+			b.WriteByte(' ')
+			space = false
+		}
+		if folded, found := foldedRunes[r]; found {
+			b.WriteString(folded)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// GetByName returns the country with the given name. The name can be the ISO
+// short or long name, an unofficial name or a translation in any locale and is
+// matched ignoring case, diacritics and punctuation. If locales are given, only
+// translations in those locales are considered. If the name is not found
+// returns nil.
+func GetByName(name string, locales ...string) *Country {
+	for _, e := range nameIndex[NormalizeName(name)] {
+		if len(locales) == 0 || containsString(locales, e.Locale) {
+			return &All[e.Country]
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+package countries_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeName(t *testing.T) {
+	assert.Equal(t, "cote d ivoire", countries.NormalizeName("Côte d'Ivoire"))
+	assert.Equal(t, "osterreich", countries.NormalizeName("  ÖSTERREICH "))
+	assert.Equal(t, "saint barthelemy", countries.NormalizeName("Saint-Barthélemy"))
+	assert.Equal(t, "cote", countries.NormalizeName("Côte"))
+	assert.Equal(t, "ドイツ", countries.NormalizeName("ドイツ"))
+}
+
+func TestGetByName(t *testing.T) {
+	for _, name := range []string{"Germany", "Allemagne", "Deutschland", "deutschland", "ドイツ", "The Federal Republic of Germany", "Alemania"} {
+		c := countries.GetByName(name)
+		if assert.NotNil(t, c, name) {
+			assert.Equal(t, "DE", c.Alpha2, name)
+		}
+	}
+	assert.Equal(t, "CI", countries.GetByName("COTE D IVOIRE").Alpha2)
+	assert.Equal(t, "AT", countries.GetByName("Osterreich").Alpha2)
+	assert.Nil(t, countries.GetByName("Atlantis"))
+	assert.Nil(t, countries.GetByName(""))
+}
+
+func TestGetByNameWithLocales(t *testing.T) {
+	assert.Equal(t, "DE", countries.GetByName("Allemagne", "fr").Alpha2)
+	assert.Equal(t, "DE", countries.GetByName("Allemagne", "it", "fr").Alpha2)
+	assert.Nil(t, countries.GetByName("Allemagne", "de"))
+}
+
+func ExampleGetByName() {
+	fmt.Println(countries.GetByName("Allemagne").Alpha2)
+	fmt.Println(countries.GetByName("Italie", "fr").Alpha2)
+	// Output:
+	// DE
+	// IT
+}
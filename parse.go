@@ -0,0 +1,148 @@
+package countries
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrInvalidFormat is returned when the input is not a well-formed alpha2,
+	// alpha3 or numeric country code.
+	ErrInvalidFormat = errors.New("countries: invalid code format")
+	// ErrUnknownCode is returned when the input is a well-formed country code
+	// but no country is assigned to it.
+	ErrUnknownCode = errors.New("countries: unknown code")
+	// ErrReservedCode is returned when the input is a well-formed country code
+	// that ISO 3166-1 reserves (user-assigned, exceptionally or transitionally
+	// reserved) and so it does not identify any country.
+	ErrReservedCode = errors.New("countries: reserved code")
+)
+
+// Parse returns the country identified by s. The input is trimmed and
+// case-insensitive and can be an alpha2, alpha3 or numeric code (with or
+// without leading zeros). Returned errors wrap ErrInvalidFormat,
+// ErrUnknownCode or ErrReservedCode and can be checked with errors.Is.
+func Parse(s string) (*Country, error) {
+	code := strings.TrimSpace(s)
+	switch {
+	case isDigits(code):
+		return ParseNumeric(code)
+	case len(code) == 2:
+		return ParseAlpha2(code)
+	case len(code) == 3:
+		return ParseAlpha3(code)
+	}
+	return nil, parseError(s, ErrInvalidFormat)
+}
+
+// ParseAlpha2 is like Parse but accepts only alpha2 codes.
+func ParseAlpha2(s string) (*Country, error) {
+	code := strings.ToUpper(strings.TrimSpace(s))
+	if len(code) != 2 || !isUpperLetters(code) {
+		return nil, parseError(s, ErrInvalidFormat)
+	}
+	if c := Get(code); c != nil {
+		return c, nil
+	}
+	if isReservedAlpha2(code) {
+		return nil, parseError(s, ErrReservedCode)
+	}
+	return nil, parseError(s, ErrUnknownCode)
+}
+
+// ParseAlpha3 is like Parse but accepts only alpha3 codes.
+func ParseAlpha3(s string) (*Country, error) {
+	code := strings.ToUpper(strings.TrimSpace(s))
+	if len(code) != 3 || !isUpperLetters(code) {
+		return nil, parseError(s, ErrInvalidFormat)
+	}
+	if c := GetByAlpha3(code); c != nil {
+		return c, nil
+	}
+	if isReservedAlpha3(code) {
+		return nil, parseError(s, ErrReservedCode)
+	}
+	return nil, parseError(s, ErrUnknownCode)
+}
+
+// ParseNumeric is like Parse but accepts only numeric codes. Leading zeros
+// are optional, so "8", "08" and "008" all identify Albania.
+func ParseNumeric(s string) (*Country, error) {
+	code := strings.TrimSpace(s)
+	if !isDigits(code) {
+		return nil, parseError(s, ErrInvalidFormat)
+	}
+	code = strings.TrimLeft(code, "0")
+	if len(code) > 3 {
+		return nil, parseError(s, ErrInvalidFormat)
+	}
+	code = strings.Repeat("0", 3-len(code)) + code
+	if c := GetByNumeric(code); c != nil {
+		return c, nil
+	}
+	if code >= "900" {
+		return nil, parseError(s, ErrReservedCode)
+	}
+	return nil, parseError(s, ErrUnknownCode)
+}
+
+func parseError(s string, err error) error {
+	return fmt.Errorf("%w: %q", err, s)
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func isUpperLetters(s string) bool {
+	for _, r := range s {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
+	}
+	return true
+}
+
+// Exceptionally and transitionally reserved codes of ISO 3166-1.
+var reservedAlpha2 = map[string]struct{}{
+	"AC": {}, "CP": {}, "DG": {}, "EA": {}, "EU": {}, "EZ": {}, "FX": {},
+	"IC": {}, "SU": {}, "TA": {}, "UK": {}, "UN": {},
+	"AN": {}, "BU": {}, "CS": {}, "NT": {}, "TP": {}, "YU": {}, "ZR": {},
+}
+
+var reservedAlpha3 = map[string]struct{}{
+	"ASC": {}, "CPT": {}, "DGA": {}, "FXX": {}, "SUN": {}, "TAA": {},
+	"ANT": {}, "BUR": {}, "CSK": {}, "NTZ": {}, "SCG": {}, "TMP": {}, "YUG": {}, "ZAR": {},
+}
+
+// isReservedAlpha2 reports whether code is reserved.
+func isReservedAlpha2(code string) bool {
+	if _, found := reservedAlpha2[code]; found {
+		return true
+	}
+	return isUserAssigned(code)
+}
+
+// isUserAssigned reports whether code is user-assigned: AA, QM to QZ, XA to
+// XZ and ZZ for alpha2 codes and the same prefixes for alpha3 codes.
+func isUserAssigned(code string) bool {
+	prefix := code[:2]
+	return prefix == "AA" || prefix == "ZZ" || code[0] == 'X' || (code[0] == 'Q' && code[1] >= 'M')
+}
+
+// isReservedAlpha3 reports whether code is reserved.
+func isReservedAlpha3(code string) bool {
+	if _, found := reservedAlpha3[code]; found {
+		return true
+	}
+	return isUserAssigned(code)
+}
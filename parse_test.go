@@ -0,0 +1,77 @@
+package countries_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, input := range []string{"IT", "it", " It ", "ITA", "ita\n", "380", " 380 "} {
+		c, err := countries.Parse(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, "IT", c.Alpha2, input)
+	}
+	for _, input := range []string{"8", "08", "008", "0008"} {
+		c, err := countries.Parse(input)
+		assert.Nil(t, err, input)
+		assert.Equal(t, "AL", c.Alpha2, input)
+	}
+
+	for _, input := range []string{"", "I", "ITAL", "I1", "1T", "IT-RM", "1234", "-12"} {
+		c, err := countries.Parse(input)
+		assert.Nil(t, c)
+		assert.True(t, errors.Is(err, countries.ErrInvalidFormat), input)
+	}
+	for _, input := range []string{"JJ", "JJJ", "111"} {
+		c, err := countries.Parse(input)
+		assert.Nil(t, c)
+		assert.True(t, errors.Is(err, countries.ErrUnknownCode), input)
+	}
+	for _, input := range []string{"EU", "uk", "AA", "QM", "XX", "ZZ", "XXX", "QZA", "YUG", "999"} {
+		c, err := countries.Parse(input)
+		assert.Nil(t, c)
+		assert.True(t, errors.Is(err, countries.ErrReservedCode), input)
+	}
+}
+
+func TestParseAlpha2(t *testing.T) {
+	c, err := countries.ParseAlpha2(" de ")
+	assert.Nil(t, err)
+	assert.Equal(t, "DE", c.Alpha2)
+
+	_, err = countries.ParseAlpha2("DEU")
+	assert.True(t, errors.Is(err, countries.ErrInvalidFormat))
+	assert.Equal(t, `countries: invalid code format: "DEU"`, err.Error())
+}
+
+func TestParseAlpha3(t *testing.T) {
+	c, err := countries.ParseAlpha3("deu")
+	assert.Nil(t, err)
+	assert.Equal(t, "DE", c.Alpha2)
+
+	_, err = countries.ParseAlpha3("DE")
+	assert.True(t, errors.Is(err, countries.ErrInvalidFormat))
+}
+
+func TestParseNumeric(t *testing.T) {
+	c, err := countries.ParseNumeric("276")
+	assert.Nil(t, err)
+	assert.Equal(t, "DE", c.Alpha2)
+
+	_, err = countries.ParseNumeric("DE")
+	assert.True(t, errors.Is(err, countries.ErrInvalidFormat))
+}
+
+func ExampleParse() {
+	c, err := countries.Parse(" usa ")
+	fmt.Println(c.Alpha2, err)
+	_, err = countries.Parse("EU")
+	fmt.Println(errors.Is(err, countries.ErrReservedCode))
+	// Output:
+	// US <nil>
+	// true
+}
@@ -0,0 +1,112 @@
+package phone
+
+import (
+	"strings"
+
+	"github.com/pioz/countries"
+)
+
+// International returns the number in international format, like
+// "+39 333 123 4567".
+func (n *Number) International() string {
+	return "+" + n.CountryCode + " " + n.groups(" ")
+}
+
+// National returns the number in national format, with the national prefix of
+// the country, like "020 7946 0018" for a number of the United Kingdom. The
+// national prefix of the North American Numbering Plan is a separate digit,
+// like in "1 212 555 1234".
+func (n *Number) National() string {
+	prefix := ""
+	if n.Country != nil {
+		prefix = dialPrefix(n.Country.NationalPrefix)
+	}
+	if prefix != "" && n.CountryCode == nanpCountryCode {
+		prefix += " "
+	}
+	return prefix + n.groups(" ")
+}
+
+// RFC3966 returns the number as a tel URI as defined by RFC 3966, like
+// "tel:+39-333-123-4567".
+func (n *Number) RFC3966() string {
+	return "tel:+" + n.CountryCode + "-" + n.groups("-")
+}
+
+// DialFrom returns the number as it must be dialed from the country from. If
+// the number has the same country code of from, it is in national format,
+// otherwise it is prefixed by the international prefix of from, like
+// "011 39 333 123 4567" when dialing an Italian number from the United States.
+// If from is nil or has no international prefix, the number is in
+// international format.
+func (n *Number) DialFrom(from *countries.Country) string {
+	if from == nil {
+		return n.International()
+	}
+	if from.CountryCode == n.CountryCode {
+		return n.National()
+	}
+	prefix := dialPrefix(from.InternationalPrefix)
+	if prefix == "" {
+		return n.International()
+	}
+	return prefix + " " + n.CountryCode + " " + n.groups(" ")
+}
+
+// groups returns the national number with the national destination code and
+// the groups of digits of the subscriber number separated by sep. The groups
+// are the ones of the numbering plan of the country, if known, or the ones of
+// groupDigits.
+func (n *Number) groups(sep string) string {
+	var groups []string
+	if d := findDestinationCode(n.Country, n.NationalNumber()); d != nil && d.length == len(n.NationalDestinationCode) {
+		groups = splitDigits(n.SubscriberNumber, d.groups)
+	}
+	if groups == nil {
+		groups = groupDigits(n.SubscriberNumber)
+	}
+	if n.NationalDestinationCode != "" {
+		groups = append([]string{n.NationalDestinationCode}, groups...)
+	}
+	return strings.Join(groups, sep)
+}
+
+// splitDigits splits digits into groups of the given sizes. If the sizes do
+// not add up to the number of digits returns nil.
+func splitDigits(digits string, sizes []int) []string {
+	total := 0
+	for _, size := range sizes {
+		total += size
+	}
+	if len(sizes) == 0 || total != len(digits) {
+		return nil
+	}
+	groups := make([]string, len(sizes))
+	for i, size := range sizes {
+		groups[i], digits = digits[:size], digits[size:]
+	}
+	return groups
+}
+
+// groupDigits splits a subscriber number into groups of digits: the last group
+// has four digits and the preceding digits are split into groups of three,
+// with the first group taking the remainder. Numbers up to four digits, and
+// remainders up to four digits, are not split.
+func groupDigits(digits string) []string {
+	if len(digits) <= 4 {
+		return []string{digits}
+	}
+	head, tail := digits[:len(digits)-4], digits[len(digits)-4:]
+	if len(head) <= 4 {
+		return []string{head, tail}
+	}
+	var groups []string
+	first := len(head) % 3
+	if first > 0 {
+		groups = append(groups, head[:first])
+	}
+	for i := first; i < len(head); i += 3 {
+		groups = append(groups, head[i:i+3])
+	}
+	return append(groups, tail)
+}
@@ -0,0 +1,89 @@
+package phone_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/pioz/countries/phone"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormat(t *testing.T) {
+	n, err := phone.Parse("+39 333 1234567", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "+393331234567", n.E164())
+	assert.Equal(t, "+39 333 123 4567", n.International())
+	assert.Equal(t, "333 123 4567", n.National())
+	assert.Equal(t, "tel:+39-333-123-4567", n.RFC3966())
+
+	gb, err := phone.Parse("+44 20 7946 0018", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "+44 20 7946 0018", gb.International())
+	assert.Equal(t, "020 7946 0018", gb.National())
+	assert.Equal(t, "tel:+44-20-7946-0018", gb.RFC3966())
+
+	us, err := phone.Parse("+1 212 555 1234", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "+1 212 555 1234", us.International())
+	assert.Equal(t, "1 212 555 1234", us.National())
+
+	for input, expected := range map[string][2]string{
+		"+33 1 23 45 67 89": {"+33 1 23 45 67 89", "01 23 45 67 89"},
+		"+44 7700 900123":   {"+44 7700 900123", "07700 900123"},
+		"+44 121 496 0000":  {"+44 121 496 0000", "0121 496 0000"},
+		"+61 2 9876 5432":   {"+61 2 9876 5432", "02 9876 5432"},
+		"+61 412 345 678":   {"+61 412 345 678", "0412 345 678"},
+		"+86 138 0013 8000": {"+86 138 0013 8000", "0138 0013 8000"},
+		"+39 06 1234 5678":  {"+39 06 1234 5678", "06 1234 5678"},
+		"+34 91 123 45 67":  {"+34 91 123 45 67", "91 123 45 67"},
+	} {
+		n, err := phone.Parse(input, "")
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, expected[0], n.International(), input)
+			assert.Equal(t, expected[1], n.National(), input)
+		}
+	}
+}
+
+func TestGroupDigits(t *testing.T) {
+	for subscriber, expected := range map[string]string{
+		"123":       "+39 333 123",
+		"1234":      "+39 333 1234",
+		"12345":     "+39 333 1 2345",
+		"123456":    "+39 333 12 3456",
+		"12345678":  "+39 333 1234 5678",
+		"123456789": "+39 333 12 345 6789",
+	} {
+		n := phone.Number{CountryCode: "39", NationalDestinationCode: "333", SubscriberNumber: subscriber}
+		assert.Equal(t, expected, n.International(), subscriber)
+	}
+	n := phone.Number{CountryCode: "39", SubscriberNumber: "1234567"}
+	assert.Equal(t, "+39 123 4567", n.International())
+}
+
+func TestDialFrom(t *testing.T) {
+	n, err := phone.Parse("+39 333 1234567", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "011 39 333 123 4567", n.DialFrom(countries.Get("US")))
+	assert.Equal(t, "00 39 333 123 4567", n.DialFrom(countries.Get("FR")))
+	assert.Equal(t, "333 123 4567", n.DialFrom(countries.Get("IT")))
+	assert.Equal(t, "333 123 4567", n.DialFrom(countries.Get("VA")))
+	assert.Equal(t, "+39 333 123 4567", n.DialFrom(nil))
+
+	us, _ := phone.Parse("+1 212 555 1234", "")
+	assert.Equal(t, "1 212 555 1234", us.DialFrom(countries.Get("CA")))
+}
+
+func ExampleNumber_DialFrom() {
+	n, _ := phone.Parse("+44 20 7946 0018", "")
+	fmt.Println(n.International())
+	fmt.Println(n.National())
+	fmt.Println(n.RFC3966())
+	fmt.Println(n.DialFrom(countries.Get("US")))
+	// Output:
+	// +44 20 7946 0018
+	// 020 7946 0018
+	// tel:+44-20-7946-0018
+	// 011 44 20 7946 0018
+}
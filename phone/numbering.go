@@ -0,0 +1,154 @@
+// Code generated by "go run generator/main.go data"; DO NOT EDIT.
+
+package phone
+
+// nationalNumberLengths maps country alpha2 codes to the lengths of their
+// national numbers, when the numbering plan replaces the ones of the country.
+var nationalNumberLengths = map[string][]int{
+	"AU": []int{9},
+	"FR": []int{9},
+	"GB": []int{9, 10},
+	"IT": []int{6, 7, 8, 9, 10, 11},
+}
+
+// destinationCodes maps country alpha2 codes to their national destination
+// codes, longest prefix first.
+var destinationCodes = map[string][]destinationCode{
+	"AU": {
+		{"2", 1, []int{4, 4}},
+		{"3", 1, []int{4, 4}},
+		{"4", 3, []int{3, 3}},
+		{"7", 1, []int{4, 4}},
+		{"8", 1, []int{4, 4}},
+	},
+	"BR": {
+		{"", 2, []int{5, 4}},
+	},
+	"CH": {
+		{"", 2, []int{3, 2, 2}},
+	},
+	"CN": {
+		{"10", 2, []int{4, 4}},
+		{"1", 3, []int{4, 4}},
+		{"2", 2, []int{4, 4}},
+		{"", 3, nil},
+	},
+	"DE": {
+		{"201", 3, nil},
+		{"211", 3, nil},
+		{"221", 3, nil},
+		{"231", 3, nil},
+		{"341", 3, nil},
+		{"351", 3, nil},
+		{"511", 3, nil},
+		{"611", 3, nil},
+		{"711", 3, nil},
+		{"911", 3, nil},
+		{"15", 3, nil},
+		{"16", 3, nil},
+		{"17", 3, nil},
+		{"30", 2, nil},
+		{"40", 2, nil},
+		{"69", 2, nil},
+		{"89", 2, nil},
+	},
+	"ES": {
+		{"91", 2, []int{3, 2, 2}},
+		{"93", 2, []int{3, 2, 2}},
+		{"6", 3, []int{3, 3}},
+		{"7", 3, []int{3, 3}},
+		{"8", 3, []int{2, 2, 2}},
+		{"9", 3, []int{2, 2, 2}},
+	},
+	"FR": {
+		{"", 1, []int{2, 2, 2, 2}},
+	},
+	"GB": {
+		{"113", 3, []int{3, 4}},
+		{"114", 3, []int{3, 4}},
+		{"115", 3, []int{3, 4}},
+		{"116", 3, []int{3, 4}},
+		{"117", 3, []int{3, 4}},
+		{"118", 3, []int{3, 4}},
+		{"121", 3, []int{3, 4}},
+		{"131", 3, []int{3, 4}},
+		{"141", 3, []int{3, 4}},
+		{"151", 3, []int{3, 4}},
+		{"161", 3, []int{3, 4}},
+		{"191", 3, []int{3, 4}},
+		{"20", 2, []int{4, 4}},
+		{"23", 2, []int{4, 4}},
+		{"24", 2, []int{4, 4}},
+		{"28", 2, []int{4, 4}},
+		{"29", 2, []int{4, 4}},
+		{"1", 4, []int{6}},
+		{"3", 3, []int{3, 4}},
+		{"7", 4, []int{6}},
+		{"8", 3, []int{3, 4}},
+		{"9", 3, []int{3, 4}},
+	},
+	"IN": {
+		{"11", 2, []int{4, 4}},
+		{"22", 2, []int{4, 4}},
+		{"33", 2, []int{4, 4}},
+		{"40", 2, []int{4, 4}},
+		{"44", 2, []int{4, 4}},
+		{"80", 2, []int{4, 4}},
+		{"6", 5, []int{5}},
+		{"7", 5, []int{5}},
+		{"8", 5, []int{5}},
+		{"9", 5, []int{5}},
+	},
+	"IT": {
+		{"010", 3, []int{3, 4}},
+		{"011", 3, []int{3, 4}},
+		{"015", 3, []int{3, 4}},
+		{"019", 3, []int{3, 4}},
+		{"030", 3, []int{3, 4}},
+		{"031", 3, []int{3, 4}},
+		{"035", 3, []int{3, 4}},
+		{"039", 3, []int{3, 4}},
+		{"040", 3, []int{3, 4}},
+		{"041", 3, []int{3, 4}},
+		{"045", 3, []int{3, 4}},
+		{"049", 3, []int{3, 4}},
+		{"050", 3, []int{3, 4}},
+		{"051", 3, []int{3, 4}},
+		{"055", 3, []int{3, 4}},
+		{"059", 3, []int{3, 4}},
+		{"070", 3, []int{3, 4}},
+		{"071", 3, []int{3, 4}},
+		{"075", 3, []int{3, 4}},
+		{"079", 3, []int{3, 4}},
+		{"080", 3, []int{3, 4}},
+		{"081", 3, []int{3, 4}},
+		{"085", 3, []int{3, 4}},
+		{"089", 3, []int{3, 4}},
+		{"090", 3, []int{3, 4}},
+		{"091", 3, []int{3, 4}},
+		{"095", 3, []int{3, 4}},
+		{"099", 3, []int{3, 4}},
+		{"02", 2, []int{4, 4}},
+		{"06", 2, []int{4, 4}},
+		{"0", 4, nil},
+		{"3", 3, []int{3, 4}},
+		{"8", 3, nil},
+	},
+	"JP": {
+		{"70", 2, []int{4, 4}},
+		{"80", 2, []int{4, 4}},
+		{"90", 2, []int{4, 4}},
+		{"3", 1, []int{4, 4}},
+		{"6", 1, []int{4, 4}},
+		{"", 2, nil},
+	},
+	"NL": {
+		{"10", 2, []int{3, 4}},
+		{"20", 2, []int{3, 4}},
+		{"30", 2, []int{3, 4}},
+		{"40", 2, []int{3, 4}},
+		{"50", 2, []int{3, 4}},
+		{"70", 2, []int{3, 4}},
+		{"6", 1, []int{8}},
+	},
+}
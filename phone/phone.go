@@ -0,0 +1,270 @@
+// Package phone parses and validates E.164 phone numbers using the telephone
+// metadata of the countries package: country calling codes, international and
+// national prefixes and the lengths of national numbers and national
+// destination codes. The national destination codes of some countries are
+// recognized by their leading digits, from the numbering plans of the data
+// directory of the countries package.
+package phone
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pioz/countries"
+	"github.com/pioz/countries/internal/phonedigits"
+)
+
+var (
+	// ErrInvalidFormat is returned when the input contains characters that are
+	// not digits or phone number punctuation, or no digits at all.
+	ErrInvalidFormat = errors.New("phone: invalid format")
+	// ErrUnknownCountryCode is returned when the input is in international
+	// format but it does not start with a known country calling code.
+	ErrUnknownCountryCode = errors.New("phone: unknown country code")
+	// ErrMissingCountryCode is returned when the input is in national format
+	// and no default region is given.
+	ErrMissingCountryCode = errors.New("phone: missing country code")
+	// ErrInvalidLength is returned when the length of the national number is
+	// not valid for the country.
+	ErrInvalidLength = errors.New("phone: invalid length")
+)
+
+// destinationCode describes the national destination codes starting with
+// prefix: their length and the sizes of the groups of digits of the subscriber
+// numbers, if known.
+type destinationCode struct {
+	prefix string
+	length int
+	groups []int
+}
+
+// nanpCountryCode is the country calling code of the North American Numbering
+// Plan.
+const nanpCountryCode = "1"
+
+// maxDigits is the maximum number of digits of an E.164 number, country code
+// included.
+const maxDigits = 15
+
+// Number is a phone number split into its E.164 components.
+type Number struct {
+	// Country is the country the number belongs to.
+	Country *countries.Country
+	// CountryCode is the country calling code, like "39".
+	CountryCode string
+	// NationalDestinationCode is the area or network code, like "333".
+	NationalDestinationCode string
+	// SubscriberNumber is the rest of the number, like "1234567".
+	SubscriberNumber string
+}
+
+// NationalNumber returns the national significant number: the national
+// destination code followed by the subscriber number.
+func (n *Number) NationalNumber() string {
+	return n.NationalDestinationCode + n.SubscriberNumber
+}
+
+// E164 returns the number in E.164 format, like "+393331234567".
+func (n *Number) E164() string {
+	return "+" + n.CountryCode + n.NationalNumber()
+}
+
+// String returns the number in E.164 format.
+func (n *Number) String() string {
+	return n.E164()
+}
+
+// Parse returns the phone number in s. The input can contain spaces, dashes,
+// dots, slashes and parentheses. Numbers starting with "+" or with an
+// international prefix (the one of defaultRegion or "00" if defaultRegion is
+// empty) are in international format; other numbers are in national format
+// and belong to defaultRegion, an alpha2 country code. The national prefix of
+// national numbers, like the "0" of "020 7946 0018", is removed, as is a
+// national prefix written as "(0)" in international numbers. The national
+// destination code is split by the longest matching prefix of the country
+// numbering plan or, if the country has a single national destination code
+// length, by that length. Returned errors wrap ErrInvalidFormat,
+// ErrUnknownCountryCode, ErrMissingCountryCode or ErrInvalidLength, or the
+// error returned by countries.ParseAlpha2 for an invalid defaultRegion, and
+// can be checked with errors.Is.
+func Parse(s, defaultRegion string) (*Number, error) {
+	var region *countries.Country
+	if defaultRegion != "" {
+		var err error
+		region, err = countries.ParseAlpha2(defaultRegion)
+		if err != nil {
+			return nil, err
+		}
+	}
+	digits, plus, ok := phonedigits.Extract(strings.Replace(s, "(0)", "", 1))
+	if !ok {
+		return nil, parseError(s, ErrInvalidFormat)
+	}
+
+	var c *countries.Country
+	international := plus
+	if !international {
+		prefix := "00"
+		if region != nil {
+			prefix = dialPrefix(region.InternationalPrefix)
+		}
+		if prefix != "" && strings.HasPrefix(digits, prefix) {
+			digits = digits[len(prefix):]
+			international = true
+		}
+	}
+	if international {
+		c = countryByCallingCode(digits, region)
+		if c == nil {
+			return nil, parseError(s, ErrUnknownCountryCode)
+		}
+		digits = digits[len(c.CountryCode):]
+	} else {
+		if region == nil {
+			return nil, parseError(s, ErrMissingCountryCode)
+		}
+		c = region
+	}
+
+	digits = stripNationalPrefix(c, digits, international)
+	if !validLength(c, digits) {
+		return nil, parseError(s, ErrInvalidLength)
+	}
+	ndcLength := destinationCodeLength(c, digits)
+	return &Number{
+		Country:                 c,
+		CountryCode:             c.CountryCode,
+		NationalDestinationCode: digits[:ndcLength],
+		SubscriberNumber:        digits[ndcLength:],
+	}, nil
+}
+
+func parseError(s string, err error) error {
+	return fmt.Errorf("%w: %q", err, s)
+}
+
+// mainCountries maps the calling codes shared by more countries to the country
+// that numbers belong to when no other rule applies.
+var mainCountries = map[string]string{
+	"1":   "US",
+	"7":   "RU",
+	"39":  "IT",
+	"44":  "GB",
+	"47":  "NO",
+	"61":  "AU",
+	"64":  "NZ",
+	"212": "MA",
+	"262": "RE",
+	"358": "FI",
+	"500": "FK",
+	"590": "GP",
+	"599": "CW",
+	"672": "NF",
+}
+
+// countryByCallingCode returns the country whose calling code is a prefix of
+// digits, as found by countries.FromPhoneNumber. If more countries share the
+// code, region wins and then the main country of the code.
+func countryByCallingCode(digits string, region *countries.Country) *countries.Country {
+	candidates, err := countries.FromPhoneNumber("+" + digits)
+	if err != nil {
+		return nil
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	for _, c := range candidates {
+		if region != nil && c.Alpha2 == region.Alpha2 {
+			return c
+		}
+	}
+	if main := countries.Get(mainCountries[candidates[0].CountryCode]); main != nil {
+		return main
+	}
+	return candidates[0]
+}
+
+// stripNationalPrefix removes the national prefix of the country from digits
+// if the number is valid without it. Numbers in international format should
+// not have a national prefix, so in that case it is removed only if the number
+// is not valid with it.
+func stripNationalPrefix(c *countries.Country, digits string, international bool) string {
+	prefix := dialPrefix(c.NationalPrefix)
+	if prefix == "" || !strings.HasPrefix(digits, prefix) {
+		return digits
+	}
+	if !validLength(c, digits[len(prefix):]) || (international && validLength(c, digits)) {
+		return digits
+	}
+	return digits[len(prefix):]
+}
+
+// dialPrefix returns the international or national prefix p of the country
+// metadata if it can be dialed, that is it is made of digits only, or an
+// empty string otherwise, like for "None".
+func dialPrefix(p string) string {
+	for _, r := range p {
+		if r < '0' || r > '9' {
+			return ""
+		}
+	}
+	return p
+}
+
+// destinationCodeLength returns the length of the national destination code of
+// the national number in digits: the length of the longest matching prefix of
+// the country numbering plan or, if the country has no numbering plan and a
+// single national destination code length, that length. Otherwise the number
+// has no national destination code and it returns 0.
+func destinationCodeLength(c *countries.Country, digits string) int {
+	if d := findDestinationCode(c, digits); d != nil {
+		return d.length
+	}
+	if _, found := destinationCodes[c.Alpha2]; found {
+		return 0
+	}
+	if len(c.NationalDestinationCodeLengths) == 1 && c.NationalDestinationCodeLengths[0] < len(digits) {
+		return c.NationalDestinationCodeLengths[0]
+	}
+	return 0
+}
+
+// findDestinationCode returns the national destination code of the country
+// numbering plan with the longest prefix of the national number in digits,
+// leaving at least one digit to the subscriber number, or nil if there is none.
+func findDestinationCode(c *countries.Country, digits string) *destinationCode {
+	if c == nil {
+		return nil
+	}
+	codes := destinationCodes[c.Alpha2]
+	for i := range codes {
+		if strings.HasPrefix(digits, codes[i].prefix) && codes[i].length < len(digits) {
+			return &codes[i]
+		}
+	}
+	return nil
+}
+
+// validLength reports whether digits is a national number of valid length for
+// the country: one of the lengths of the country numbering plan, if any, or of
+// country.NationalNumberLengths. If the country has no length metadata, only
+// the E.164 maximum length is checked.
+func validLength(c *countries.Country, digits string) bool {
+	if len(digits) == 0 || len(c.CountryCode)+len(digits) > maxDigits {
+		return false
+	}
+	lengths, found := nationalNumberLengths[c.Alpha2]
+	if !found {
+		lengths = c.NationalNumberLengths
+	}
+	if len(lengths) == 0 {
+		return true
+	}
+	for _, l := range lengths {
+		if l == len(digits) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package phone_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/pioz/countries/phone"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	for _, input := range []string{"+1 (212) 555-1234", "+12125551234", "0012125551234"} {
+		n, err := phone.Parse(input, "")
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, "US", n.Country.Alpha2, input)
+			assert.Equal(t, "1", n.CountryCode, input)
+			assert.Equal(t, "212", n.NationalDestinationCode, input)
+			assert.Equal(t, "5551234", n.SubscriberNumber, input)
+			assert.Equal(t, "+12125551234", n.E164(), input)
+		}
+	}
+	for _, input := range []string{"212 555 1234", "1 212 555 1234", "011 1 212 555 1234"} {
+		n, err := phone.Parse(input, "US")
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, "+12125551234", n.String(), input)
+		}
+	}
+
+	n, err := phone.Parse("020 7946 0018", "gb")
+	assert.Nil(t, err)
+	assert.Equal(t, "GB", n.Country.Alpha2)
+	assert.Equal(t, "20", n.NationalDestinationCode)
+	assert.Equal(t, "2079460018", n.NationalNumber())
+	assert.Equal(t, "+442079460018", n.E164())
+
+	n, err = phone.Parse("+44 (0)20 7946 0018", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "+442079460018", n.E164())
+
+	n, err = phone.Parse("01 23 45 67 89", "FR")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", n.NationalDestinationCode)
+	assert.Equal(t, "23456789", n.SubscriberNumber)
+
+	for _, input := range []string{"+39 333 1234567", "0039 333 1234567", "333 1234567"} {
+		n, err = phone.Parse(input, "IT")
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, "IT", n.Country.Alpha2, input)
+			assert.Equal(t, "333", n.NationalDestinationCode, input)
+			assert.Equal(t, "1234567", n.SubscriberNumber, input)
+		}
+	}
+	n, err = phone.Parse("0039 333 1234567", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "+393331234567", n.E164())
+
+	n, err = phone.Parse("06 12345678", "IT")
+	assert.Nil(t, err)
+	assert.Equal(t, "06", n.NationalDestinationCode)
+	assert.Equal(t, "12345678", n.SubscriberNumber)
+	assert.Equal(t, "+390612345678", n.E164())
+
+	n, err = phone.Parse("0571 123456", "IT")
+	assert.Nil(t, err)
+	assert.Equal(t, "0571", n.NationalDestinationCode)
+}
+
+func TestParseDestinationCode(t *testing.T) {
+	for input, expected := range map[string][2]string{
+		"+44 7700 900123":   {"7700", "900123"},
+		"+44 121 496 0000":  {"121", "4960000"},
+		"+44 20 7946 0018":  {"20", "79460018"},
+		"+61 2 9876 5432":   {"2", "98765432"},
+		"+61 412 345 678":   {"412", "345678"},
+		"+86 138 0013 8000": {"138", "00138000"},
+		"+86 10 1234 5678":  {"10", "12345678"},
+		"+33 6 12 34 56 78": {"6", "12345678"},
+		"+1 212 555 1234":   {"212", "5551234"},
+	} {
+		n, err := phone.Parse(input, "")
+		if assert.Nil(t, err, input) {
+			assert.Equal(t, expected[0], n.NationalDestinationCode, input)
+			assert.Equal(t, expected[1], n.SubscriberNumber, input)
+		}
+	}
+
+	// Without a numbering plan or a single length the national destination
+	// code is not split.
+	n, err := phone.Parse("+49 2345 678901", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "", n.NationalDestinationCode)
+	assert.Equal(t, "2345678901", n.SubscriberNumber)
+}
+
+func TestParseSharedCountryCode(t *testing.T) {
+	n, err := phone.Parse("+1 268 460 1234", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "AG", n.Country.Alpha2)
+
+	n, err = phone.Parse("+1 416 555 1234", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "CA", n.Country.Alpha2)
+
+	n, err = phone.Parse("+1 212 555 1234", "CA")
+	assert.Nil(t, err)
+	assert.Equal(t, "US", n.Country.Alpha2)
+
+	n, err = phone.Parse("+44 1481 123456", "GG")
+	assert.Nil(t, err)
+	assert.Equal(t, "GG", n.Country.Alpha2)
+
+	n, err = phone.Parse("+7 727 123 4567", "KZ")
+	assert.Nil(t, err)
+	assert.Equal(t, "KZ", n.Country.Alpha2)
+
+	n, err = phone.Parse("+7 495 123 4567", "")
+	assert.Nil(t, err)
+	assert.Equal(t, "RU", n.Country.Alpha2)
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, input := range []string{"", "   ", "+", "abc", "+39 333 12345x", "++39 333 123456"} {
+		_, err := phone.Parse(input, "IT")
+		assert.True(t, errors.Is(err, phone.ErrInvalidFormat), input)
+	}
+	_, err := phone.Parse("+999 123456", "")
+	assert.True(t, errors.Is(err, phone.ErrUnknownCountryCode))
+	_, err = phone.Parse("333 1234567", "")
+	assert.True(t, errors.Is(err, phone.ErrMissingCountryCode))
+	for _, input := range []string{"+39 12", "+39 333 123456789012", "+1 212 555 12345", "+44 20 7946 00", "+49 1234567890123456"} {
+		_, err = phone.Parse(input, "")
+		assert.True(t, errors.Is(err, phone.ErrInvalidLength), input)
+	}
+	_, err = phone.Parse("333 1234567", "JJ")
+	assert.True(t, errors.Is(err, countries.ErrUnknownCode))
+}
+
+func ExampleParse() {
+	n, _ := phone.Parse("020 7946 0018", "GB")
+	fmt.Println(n.Country.Alpha2, n.CountryCode, n.NationalDestinationCode, n.SubscriberNumber)
+	fmt.Println(n.E164())
+	// Output:
+	// GB 44 20 79460018
+	// +442079460018
+}
@@ -0,0 +1,102 @@
+package countries
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ErrPostalCodeMismatch is returned when a postal code belongs to a
+// subdivision that is not the given region.
+var ErrPostalCodeMismatch = errors.New("countries: postal code does not match region")
+
+// postalCodeRange is a range of postal code prefixes, bounds included, that
+// belongs to a subdivision.
+type postalCodeRange struct {
+	From string
+	To   string
+	Code string
+}
+
+// postalCodeHint is an example postal code and a human-readable description of
+// the postal code format of a country.
+type postalCodeHint struct {
+	Example string
+	Hint    string
+}
+
+// PostalCodeExample returns an example of valid postal code for the country,
+// like "12345". If the country does not have postal codes returns an empty
+// string.
+func (c *Country) PostalCodeExample() string {
+	return postalCodeHints[c.Alpha2].Example
+}
+
+// PostalCodeHint returns a human-readable description of the postal code
+// format of the country, like "5 digits" or "A9A 9A9", where 9 stands for a
+// digit, A for a letter and * for a letter or a digit. Formats with more
+// alternatives or optional parts list them, like "999-9999 or 4 to 5 digits"
+// or "3, 5 or 6 digits". If the country does not have postal codes returns an
+// empty string.
+func (c *Country) PostalCodeHint() string {
+	return postalCodeHints[c.Alpha2].Hint
+}
+
+// SubdivisionForPostalCode returns the subdivision the postal code belongs to,
+// for countries whose postal codes are structured by subdivision, like the
+// United States, Canada and Italy, as listed in data/postal_codes.yaml. The
+// postal code must have a valid format for the country, after normalization.
+// The second value is false if the subdivision is not known.
+func (c *Country) SubdivisionForPostalCode(postalCode string) (Subdivision, bool) {
+	postalCode, err := c.NormalizePostalCode(postalCode)
+	if err != nil {
+		return Subdivision{}, false
+	}
+	compact := compactPostalCode(postalCode)
+	for _, r := range postalCodeRanges[c.Alpha2] {
+		if len(compact) < len(r.From) {
+			continue
+		}
+		prefix := compact[:len(r.From)]
+		if prefix >= r.From && prefix <= r.To {
+			s, found := c.Subdivisions[r.Code]
+			return s, found
+		}
+	}
+	return Subdivision{}, false
+}
+
+// CheckPostalCodeRegion returns an error wrapping ErrPostalCodeMismatch if the
+// postal code belongs to a subdivision other than region. The region is found
+// by code or name as in FormatAddress and also matches when it contains the
+// subdivision of the postal code, like a region of its province. If the
+// region or the subdivision of the postal code is unknown returns nil, since
+// there is nothing to compare.
+func (c *Country) CheckPostalCodeRegion(postalCode, region string) error {
+	s, found := c.SubdivisionForPostalCode(postalCode)
+	if !found {
+		return nil
+	}
+	r := c.findSubdivision(region)
+	if r.Code == "" {
+		return nil
+	}
+	for p := s; p.Code != ""; p = p.Parent() {
+		if p.Code == r.Code {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %q is in %s, not in %s", ErrPostalCodeMismatch, postalCode, s.ISOCode(), r.ISOCode())
+}
+
+// compactPostalCode returns the postal code upper cased and without spaces
+// and dashes.
+func compactPostalCode(postalCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || unicode.IsSpace(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, postalCode)
+}
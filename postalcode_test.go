@@ -0,0 +1,183 @@
+package countries_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubdivisionForPostalCode(t *testing.T) {
+	for _, test := range []struct {
+		alpha2, postalCode, code string
+	}{
+		{"US", "14214", "NY"},
+		{"US", "90210", "CA"},
+		{"US", "73301", "TX"},
+		{"US", "73101", "OK"},
+		{"US", "00901", "PR"},
+		{"US", "99501-1234", "AK"},
+		{"CA", "K1A 0B1", "ON"},
+		{"CA", "h2x1y4", "QC"},
+		{"CA", "X0A 0H0", "NU"},
+		{"CA", "X1A 2P7", "NT"},
+		{"IT", "97011", "RG"},
+		{"IT", "00185", "RM"},
+		{"IT", "20900", "MB"},
+		{"IT", "20121", "MI"},
+		{"IT", "33170", "PN"},
+		{"IT", "33100", "UD"},
+		{"IT", "34170", "GO"},
+		{"IT", "34121", "TS"},
+		{"IT", "11100", "23"},
+	} {
+		s, found := countries.Get(test.alpha2).SubdivisionForPostalCode(test.postalCode)
+		assert.True(t, found, test.postalCode)
+		assert.Equal(t, test.code, s.Code, test.postalCode)
+	}
+
+	_, found := countries.Get("US").SubdivisionForPostalCode("96910")
+	assert.False(t, found)
+	_, found = countries.Get("US").SubdivisionForPostalCode("1421")
+	assert.False(t, found)
+	_, found = countries.Get("DE").SubdivisionForPostalCode("10115")
+	assert.False(t, found)
+	_, found = countries.Get("JM").SubdivisionForPostalCode("10115")
+	assert.False(t, found)
+}
+
+func TestCheckPostalCodeRegion(t *testing.T) {
+	us := countries.Get("US")
+	assert.Nil(t, us.CheckPostalCodeRegion("14214", "NY"))
+	assert.Nil(t, us.CheckPostalCodeRegion("14214", "New York"))
+	err := us.CheckPostalCodeRegion("14214", "CA")
+	assert.True(t, errors.Is(err, countries.ErrPostalCodeMismatch))
+	assert.Contains(t, err.Error(), "US-NY")
+	assert.Contains(t, err.Error(), "US-CA")
+
+	it := countries.Get("IT")
+	assert.Nil(t, it.CheckPostalCodeRegion("97011", "RG"))
+	assert.Nil(t, it.CheckPostalCodeRegion("97011", "Sicilia"))
+	assert.True(t, errors.Is(it.CheckPostalCodeRegion("97011", "Toscana"), countries.ErrPostalCodeMismatch))
+
+	// Unknown regions and postal codes cannot be checked.
+	assert.Nil(t, us.CheckPostalCodeRegion("14214", "xx"))
+	assert.Nil(t, us.CheckPostalCodeRegion("xx", "NY"))
+}
+
+func TestPostalCodeExample(t *testing.T) {
+	assert.Equal(t, "12345", countries.Get("IT").PostalCodeExample())
+	assert.Equal(t, "", countries.Get("JM").PostalCodeExample())
+	for _, c := range countries.All {
+		if !c.HasPostalCode() {
+			continue
+		}
+		example := c.PostalCodeExample()
+		assert.True(t, c.MatchPostalCode(example), "%s: %q does not match %s", c.Alpha2, example, c.PostalCodeFormat)
+		assert.NotEqual(t, "", c.PostalCodeHint(), c.Alpha2)
+	}
+}
+
+func TestPostalCodeHint(t *testing.T) {
+	assert.Equal(t, "5 digits", countries.Get("IT").PostalCodeHint())
+	assert.Equal(t, "99999-9999 or 5 digits", countries.Get("US").PostalCodeHint())
+	assert.Equal(t, "4 or 6 digits", countries.Get("AM").PostalCodeHint())
+	assert.Equal(t, "3, 5 or 6 digits", countries.Get("TW").PostalCodeHint())
+	assert.Equal(t, "5 or 7 digits", countries.Get("IL").PostalCodeHint())
+	assert.Equal(t, "A9999AAA, 9999AAA, A9999 or 4 digits", countries.Get("AR").PostalCodeHint())
+	assert.Equal(t, "A9A 9A9", countries.Get("CA").PostalCodeHint())
+	assert.Equal(t, "AA9* 9AA, A9* 9AA, AA9 9AA, A9 9AA, BFPO 9999 or GIR 0AA", countries.Get("GB").PostalCodeHint())
+	assert.Equal(t, "9999 AA", countries.Get("NL").PostalCodeHint())
+	assert.Equal(t, "99999-999", countries.Get("BR").PostalCodeHint())
+	assert.Equal(t, "*** ****", countries.Get("IE").PostalCodeHint())
+	assert.Equal(t, "999-9999 or 4 to 5 digits", countries.Get("CR").PostalCodeHint())
+	assert.Equal(t, "AD999", countries.Get("AD").PostalCodeHint())
+	assert.Equal(t, "CP 9999", countries.Get("SV").PostalCodeHint())
+	assert.Equal(t, "AA **", countries.Get("BM").PostalCodeHint())
+	assert.Equal(t, "", countries.Get("JM").PostalCodeHint())
+}
+
+func TestPostalCodeHintMatchesExample(t *testing.T) {
+	for _, c := range countries.All {
+		if !c.HasPostalCode() {
+			continue
+		}
+		hint, example := c.PostalCodeHint(), c.PostalCodeExample()
+		assert.True(t, hintMatches(hint, example), "%s: %q does not match %q", c.Alpha2, example, hint)
+	}
+}
+
+// hintMatches reports whether s matches one of the alternatives of a postal
+// code hint. The lengths of the digits, always the last alternative, are a
+// range like "4 to 5 digits" or a list like "3, 5 or 6 digits".
+func hintMatches(hint, s string) bool {
+	digits := regexp.MustCompile(`(?:^|, | or )((?:\d+, )*\d+)(?: (to|or) (\d+))? digits$`)
+	if m := digits.FindStringSubmatch(hint); m != nil {
+		hint = hint[:len(hint)-len(m[0])]
+		if strings.Trim(s, "0123456789") == "" {
+			for _, n := range strings.Split(m[1], ", ") {
+				min, _ := strconv.Atoi(n)
+				max := min
+				switch m[2] {
+				case "to":
+					max, _ = strconv.Atoi(m[3])
+				case "or":
+					other, _ := strconv.Atoi(m[3])
+					if len(s) == other {
+						return true
+					}
+				}
+				if len(s) >= min && len(s) <= max {
+					return true
+				}
+			}
+		}
+	}
+	for _, alternative := range regexp.MustCompile(`, | or `).Split(hint, -1) {
+		if maskMatches(alternative, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// maskMatches reports whether s matches a postal code mask.
+func maskMatches(mask, s string) bool {
+	if len(mask) != len(s) {
+		return false
+	}
+	for i := range mask {
+		isDigit := s[i] >= '0' && s[i] <= '9'
+		isLetter := s[i] >= 'A' && s[i] <= 'Z'
+		switch {
+		case mask[i] == '9' && isDigit, mask[i] == 'A' && isLetter, mask[i] == '*' && (isDigit || isLetter):
+		case mask[i] != s[i]:
+			return false
+		}
+	}
+	return true
+}
+
+func ExampleCountry_SubdivisionForPostalCode() {
+	c := countries.Get("US")
+	s, _ := c.SubdivisionForPostalCode("14214")
+	fmt.Println(s.Name)
+	fmt.Println(c.CheckPostalCodeRegion("14214", "California"))
+	// Output:
+	// New York
+	// countries: postal code does not match region: "14214" is in US-NY, not in US-CA
+}
+
+func ExampleCountry_PostalCodeHint() {
+	c := countries.Get("CA")
+	fmt.Println(c.PostalCodeExample())
+	fmt.Println(c.PostalCodeHint())
+	// Output:
+	// A1B 2C3
+	// A9A 9A9
+}
@@ -0,0 +1,195 @@
+package countries
+
+import (
+	"sort"
+	"strings"
+)
+
+// SearchOptions configures Search.
+type SearchOptions struct {
+	// Locales restricts the search to translations in these locales. If empty,
+	// all names in all locales are searched.
+	Locales []string
+	// MaxEdits is the maximum number of single character edits (insertions,
+	// deletions or substitutions) tolerated for typo matching. Zero disables
+	// typo matching.
+	MaxEdits int
+	// Limit is the maximum number of returned matches. Zero means no limit.
+	Limit int
+}
+
+// Match is a country found by Search.
+type Match struct {
+	Country *Country
+	// Name is the name variant that matched the query.
+	Name string
+	// Locale is the locale of Name or an empty string for ISO and unofficial
+	// names.
+	Locale string
+	// Score ranks the match: 1 for an exact match, lower values for prefix,
+	// substring and typo matches.
+	Score float64
+}
+
+// Scores assigned to the different kinds of match.
+const (
+	scoreExact      = 1.0
+	scorePrefix     = 0.9
+	scoreWordPrefix = 0.8
+	scoreSubstring  = 0.7
+	scoreTypo       = 0.5
+)
+
+// Search returns the countries whose names match query, sorted by descending
+// score. The query is normalized like NormalizeName and is matched against the
+// ISO names, unofficial names and translations of the countries as an exact
+// name, a prefix, a substring or, if opts.MaxEdits is positive, a name within
+// opts.MaxEdits edits. Each country appears at most once, with its best
+// matching name.
+func Search(query string, opts SearchOptions) []Match {
+	q := NormalizeName(query)
+	if q == "" {
+		return nil
+	}
+	best := make(map[int]Match)
+	for key, entries := range nameIndex {
+		score := matchScore(q, key, opts.MaxEdits)
+		if score == 0 {
+			continue
+		}
+		for _, e := range entries {
+			if len(opts.Locales) > 0 && !containsString(opts.Locales, e.Locale) {
+				continue
+			}
+			candidate := Match{Country: &All[e.Country], Name: e.Name, Locale: e.Locale, Score: score}
+			if m, found := best[e.Country]; found && !betterMatch(candidate, m) {
+				continue
+			}
+			best[e.Country] = candidate
+		}
+	}
+	result := make([]Match, 0, len(best))
+	for _, m := range best {
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		if len(result[i].Name) != len(result[j].Name) {
+			return len(result[i].Name) < len(result[j].Name)
+		}
+		return result[i].Country.Alpha2 < result[j].Country.Alpha2
+	})
+	if opts.Limit > 0 && len(result) > opts.Limit {
+		result = result[:opts.Limit]
+	}
+	return result
+}
+
+// betterMatch reports whether the match a should replace the match b of the
+// same country. On equal scores the shorter name wins, so that the returned
+// variant is the closest to the query; remaining ties are broken by the kind
+// of name, ISO names first, then by locale and name, so that the result does
+// not depend on the iteration order of the name index.
+func betterMatch(a, b Match) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	if len(a.Name) != len(b.Name) {
+		return len(a.Name) < len(b.Name)
+	}
+	if ka, kb := a.nameKind(), b.nameKind(); ka != kb {
+		return ka < kb
+	}
+	if a.Locale != b.Locale {
+		return a.Locale < b.Locale
+	}
+	return a.Name < b.Name
+}
+
+// nameKind returns the priority of the kind of the matched name: the ISO
+// short name, the ISO long name, an unofficial name and a translation.
+func (m Match) nameKind() int {
+	switch {
+	case m.Locale != "":
+		return 3
+	case m.Name == m.Country.ISOShortName:
+		return 0
+	case m.Name == m.Country.ISOLongName:
+		return 1
+	}
+	return 2
+}
+
+// matchScore returns the score of the normalized name against the normalized
+// query q, or zero if they do not match.
+func matchScore(q, name string, maxEdits int) float64 {
+	switch {
+	case name == q:
+		return scoreExact
+	case strings.HasPrefix(name, q):
+		return scorePrefix
+	case strings.Contains(name, " "+q):
+		return scoreWordPrefix
+	case strings.Contains(name, q):
+		return scoreSubstring
+	}
+	if maxEdits <= 0 {
+		return 0
+	}
+	// Compare the query with the whole name and with the name prefix of the
+	// same length, so that typos are tolerated while typing.
+	qr, nr := []rune(q), []rune(name)
+	d := -1
+	if abs(len(nr)-len(qr)) <= maxEdits {
+		d = editDistance(qr, nr)
+	}
+	if len(nr) > len(qr) {
+		if p := editDistance(qr, nr[:len(qr)]); d < 0 || p < d {
+			d = p
+		}
+	}
+	if d < 0 || d > maxEdits || d >= len(qr) {
+		return 0
+	}
+	return scoreTypo / float64(d+1)
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
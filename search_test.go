@@ -0,0 +1,70 @@
+package countries_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearch(t *testing.T) {
+	matches := countries.Search("Italy", countries.SearchOptions{})
+	assert.Equal(t, "IT", matches[0].Country.Alpha2)
+	assert.Equal(t, "Italy", matches[0].Name)
+	assert.Equal(t, 1.0, matches[0].Score)
+
+	matches = countries.Search("ital", countries.SearchOptions{Locales: []string{"it"}})
+	assert.Equal(t, 1, len(matches))
+	assert.Equal(t, "IT", matches[0].Country.Alpha2)
+	assert.Equal(t, "Italia", matches[0].Name)
+	assert.Equal(t, "it", matches[0].Locale)
+
+	matches = countries.Search("zealand", countries.SearchOptions{Locales: []string{"en"}})
+	assert.Equal(t, "NZ", matches[0].Country.Alpha2)
+
+	matches = countries.Search("germnay", countries.SearchOptions{Locales: []string{"en"}})
+	assert.Equal(t, 0, len(matches))
+	matches = countries.Search("germnay", countries.SearchOptions{Locales: []string{"en"}, MaxEdits: 2})
+	assert.Equal(t, "DE", matches[0].Country.Alpha2)
+	assert.Equal(t, "Germany", matches[0].Name)
+	assert.Less(t, matches[0].Score, 0.5)
+
+	matches = countries.Search("a", countries.SearchOptions{Limit: 3})
+	assert.Equal(t, 3, len(matches))
+	for i := 1; i < len(matches); i++ {
+		assert.GreaterOrEqual(t, matches[i-1].Score, matches[i].Score)
+	}
+
+	assert.Nil(t, countries.Search(" ", countries.SearchOptions{}))
+}
+
+func TestSearchOneMatchPerCountry(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, m := range countries.Search("united", countries.SearchOptions{}) {
+		assert.False(t, seen[m.Country.Alpha2], m.Country.Alpha2)
+		seen[m.Country.Alpha2] = true
+	}
+	assert.True(t, seen["US"])
+	assert.True(t, seen["GB"])
+}
+
+func TestSearchDeterministic(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		matches := countries.Search("ital", countries.SearchOptions{})
+		assert.Equal(t, "IT", matches[0].Country.Alpha2)
+		assert.Equal(t, "Italy", matches[0].Name)
+		assert.Equal(t, "", matches[0].Locale)
+	}
+}
+
+func ExampleSearch() {
+	matches := countries.Search("guinea", countries.SearchOptions{Locales: []string{"en"}, Limit: 3})
+	for _, m := range matches {
+		fmt.Println(m.Country.Alpha2, m.Name)
+	}
+	// Output:
+	// GN Guinea
+	// GW Guinea-Bissau
+	// PG Papua New Guinea
+}
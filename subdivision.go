@@ -0,0 +1,279 @@
+package countries
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ErrUnknownSubdivision is returned when the country of an ISO 3166-2 code
+// exists but it has no subdivision with that code.
+var ErrUnknownSubdivision = errors.New("countries: unknown subdivision")
+
+// GetSubdivision returns the country and the subdivision identified by the full
+// ISO 3166-2 code, like "US-CA" or "IT-RG". The code is trimmed and
+// case-insensitive. Returned errors wrap ErrInvalidFormat, ErrUnknownCode or
+// ErrUnknownSubdivision and can be checked with errors.Is.
+func GetSubdivision(code string) (*Country, Subdivision, error) {
+	parts := strings.SplitN(strings.ToUpper(strings.TrimSpace(code)), "-", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, Subdivision{}, parseError(code, ErrInvalidFormat)
+	}
+	alpha2, local := parts[0], parts[1]
+	c, err := ParseAlpha2(alpha2)
+	if err != nil {
+		if errors.Is(err, ErrInvalidFormat) {
+			return nil, Subdivision{}, parseError(code, ErrInvalidFormat)
+		}
+		return nil, Subdivision{}, parseError(code, ErrUnknownCode)
+	}
+	s, found := c.Subdivisions[local]
+	if !found {
+		return c, Subdivision{}, parseError(code, ErrUnknownSubdivision)
+	}
+	return c, s, nil
+}
+
+// ISOCode returns the full ISO 3166-2 code of the subdivision, like "US-CA".
+// If the subdivision is a zero value returns an empty string.
+func (s Subdivision) ISOCode() string {
+	if s.CountryAlpha2 == "" || s.Code == "" {
+		return ""
+	}
+	return s.CountryAlpha2 + "-" + s.Code
+}
+
+// Kind returns the type of the subdivision as a SubdivisionType, which can be
+// compared with the SubdivisionType constants and localized with Label.
+func (s Subdivision) Kind() SubdivisionType {
+	return SubdivisionType(s.Type)
+}
+
+// Parent returns the subdivision that contains s, like the region of an Italian
+// province. If s is a top-level subdivision returns a zero value Subdivision.
+func (s Subdivision) Parent() Subdivision {
+	if s.ParentCode == "" {
+		return Subdivision{}
+	}
+	c := Get(s.CountryAlpha2)
+	if c == nil {
+		return Subdivision{}
+	}
+	return c.Subdivisions[s.ParentCode]
+}
+
+// Children returns the subdivisions directly contained in s, ordered by code.
+func (s Subdivision) Children() []Subdivision {
+	result := make([]Subdivision, 0)
+	if s.Code == "" {
+		return result
+	}
+	c := Get(s.CountryAlpha2)
+	if c == nil {
+		return result
+	}
+	for _, child := range c.Subdivisions {
+		if child.ParentCode == s.Code {
+			result = append(result, child)
+		}
+	}
+	sortSubdivisions(result)
+	return result
+}
+
+// TopLevelSubdivisions returns the country's subdivisions that have no parent,
+// ordered by code. For countries without hierarchy data these are all the
+// subdivisions.
+func (c *Country) TopLevelSubdivisions() []Subdivision {
+	result := make([]Subdivision, 0)
+	for _, s := range c.Subdivisions {
+		if s.ParentCode == "" {
+			result = append(result, s)
+		}
+	}
+	sortSubdivisions(result)
+	return result
+}
+
+// WalkSubdivisions walks the country's subdivision tree depth-first, calling fn
+// for each subdivision before its children. Top-level subdivisions have depth
+// 0 and siblings are visited in code order. If fn returns an error the walk
+// stops and WalkSubdivisions returns that error.
+func (c *Country) WalkSubdivisions(fn func(s Subdivision, depth int) error) error {
+	children := make(map[string][]Subdivision)
+	for _, s := range c.Subdivisions {
+		children[s.ParentCode] = append(children[s.ParentCode], s)
+	}
+	var walk func(parent string, depth int) error
+	walk = func(parent string, depth int) error {
+		subdivisions := children[parent]
+		sortSubdivisions(subdivisions)
+		for _, s := range subdivisions {
+			if err := fn(s, depth); err != nil {
+				return err
+			}
+			if err := walk(s.Code, depth+1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return walk("", 0)
+}
+
+func sortSubdivisions(subdivisions []Subdivision) {
+	sort.Slice(subdivisions, func(i, j int) bool {
+		return subdivisions[i].Code < subdivisions[j].Code
+	})
+}
+
+// properTypeWords are the words capitalized by SubdivisionType.Label.
+var properTypeWords = map[string]struct{}{
+	"africa":   {},
+	"european": {},
+	"london":   {},
+	"north":    {},
+	"pakistan": {},
+}
+
+// Label returns the human-readable label of the subdivision type translated in
+// locale, like "Provincia" for "it". A locale with a region, like "de_CH",
+// falls back to its language; types without a translation in locale have an
+// English label, like "Province" or "Two-tier county".
+func (t SubdivisionType) Label(locale string) string {
+	if t == "" {
+		return ""
+	}
+	if label, found := localizedLabel(subdivisionTypeLabels[t], locale); found {
+		return label
+	}
+	words := make([]string, 0)
+	for _, w := range strings.Split(string(t), "_") {
+		if w == "" {
+			continue
+		}
+		if _, found := properTypeWords[w]; found || len(words) == 0 {
+			r, size := utf8.DecodeRuneInString(w)
+			w = string(unicode.ToUpper(r)) + w[size:]
+		}
+		words = append(words, w)
+	}
+	return strings.Join(words, " ")
+}
+
+// localizedLabel returns the label of labels in locale. A locale with a region,
+// like "de_CH" or "de-CH", falls back to its language.
+func localizedLabel(labels map[string]string, locale string) (string, bool) {
+	locale = strings.ReplaceAll(locale, "-", "_")
+	if label, found := labels[locale]; found {
+		return label, true
+	}
+	if i := strings.Index(locale, "_"); i > 0 {
+		if label, found := labels[locale[:i]]; found {
+			return label, true
+		}
+	}
+	return "", false
+}
+
+// SubdivisionsOfType returns the country's subdivisions of type t, ordered by
+// code.
+func (c *Country) SubdivisionsOfType(t SubdivisionType) []Subdivision {
+	result := make([]Subdivision, 0)
+	for _, s := range c.Subdivisions {
+		if s.Kind() == t {
+			result = append(result, s)
+		}
+	}
+	sortSubdivisions(result)
+	return result
+}
+
+// SubdivisionTypes returns the distinct types of the country's subdivisions,
+// ordered alphabetically.
+func (c *Country) SubdivisionTypes() []SubdivisionType {
+	result := make([]SubdivisionType, 0)
+	set := make(map[SubdivisionType]struct{})
+	for _, s := range c.Subdivisions {
+		if _, found := set[s.Kind()]; !found && s.Type != "" {
+			set[s.Kind()] = struct{}{}
+			result = append(result, s.Kind())
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i] < result[j]
+	})
+	return result
+}
+
+// SubdivisionOrder is the order of the subdivisions returned by
+// Country.SubdivisionList.
+type SubdivisionOrder int
+
+// Subdivision orders.
+const (
+	SubdivisionOrderCode SubdivisionOrder = iota
+	SubdivisionOrderName
+)
+
+// SubdivisionListOptions configures Country.SubdivisionList.
+type SubdivisionListOptions struct {
+	// Order is the sort order of the subdivisions.
+	Order SubdivisionOrder
+	// Locale, with SubdivisionOrderName, sorts the subdivisions by their
+	// translation in this locale. Subdivisions without a translation are sorted
+	// by their name.
+	Locale string
+}
+
+// SubdivisionList returns all the country's subdivisions in a stable order. By
+// name orders are collated ignoring case and diacritics, like NormalizeName;
+// ties are broken by code.
+func (c *Country) SubdivisionList(opts SubdivisionListOptions) []Subdivision {
+	result := make([]Subdivision, 0, len(c.Subdivisions))
+	for _, s := range c.Subdivisions {
+		result = append(result, s)
+	}
+	sortSubdivisions(result)
+	if opts.Order == SubdivisionOrderName {
+		keys := make(map[string]string, len(result))
+		for _, s := range result {
+			keys[s.Code] = NormalizeName(s.localizedName(opts.Locale))
+		}
+		sort.SliceStable(result, func(i, j int) bool {
+			return keys[result[i].Code] < keys[result[j].Code]
+		})
+	}
+	return result
+}
+
+// localizedName returns the translation of the subdivision name in locale or
+// the name if the translation is missing.
+func (s Subdivision) localizedName(locale string) string {
+	if name := s.Translations[locale]; name != "" {
+		return name
+	}
+	return s.Name
+}
+
+// SubdivisionsByName returns all the country's subdivisions matching name, as
+// in SubdivisionByName, so that ambiguous names can be detected. Subdivisions
+// are ordered by the relevance of the matching name, then by code. If the name
+// is not found returns an empty slice.
+func (c *Country) SubdivisionsByName(name string, locales ...string) []Subdivision {
+	result := make([]Subdivision, 0)
+	seen := make(map[string]struct{})
+	for _, e := range subdivisionNames(c.Alpha2)[NormalizeName(name)] {
+		if len(locales) > 0 && !containsString(locales, e.Locale) {
+			continue
+		}
+		if _, found := seen[e.Code]; found {
+			continue
+		}
+		seen[e.Code] = struct{}{}
+		result = append(result, c.Subdivisions[e.Code])
+	}
+	return result
+}
@@ -0,0 +1,230 @@
+package countries_test
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/pioz/countries"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSubdivision(t *testing.T) {
+	c, s, err := countries.GetSubdivision("US-CA")
+	assert.Nil(t, err)
+	assert.Equal(t, "US", c.Alpha2)
+	assert.Equal(t, "California", s.Name)
+
+	c, s, err = countries.GetSubdivision(" it-rg ")
+	assert.Nil(t, err)
+	assert.Equal(t, "IT", c.Alpha2)
+	assert.Equal(t, "Ragusa", s.Name)
+
+	for _, code := range []string{"", "US", "US-", "USCA", "U1-CA"} {
+		_, _, err = countries.GetSubdivision(code)
+		assert.True(t, errors.Is(err, countries.ErrInvalidFormat), code)
+	}
+
+	_, _, err = countries.GetSubdivision("JJ-CA")
+	assert.True(t, errors.Is(err, countries.ErrUnknownCode))
+
+	c, s, err = countries.GetSubdivision("US-XX")
+	assert.True(t, errors.Is(err, countries.ErrUnknownSubdivision))
+	assert.Equal(t, "US", c.Alpha2)
+	assert.Equal(t, "", s.Name)
+}
+
+func TestSubdivisionISOCode(t *testing.T) {
+	s := countries.Get("IT").Subdivision("RG")
+	assert.Equal(t, "IT-RG", s.ISOCode())
+
+	s = countries.Get("IT").Subdivision("XX")
+	assert.Equal(t, "", s.ISOCode())
+
+	assert.Equal(t, "US-CA", countries.Get("US").Subdivisions["CA"].ISOCode())
+
+	for _, c := range countries.All {
+		for code, s := range c.Subdivisions {
+			_, found, err := countries.GetSubdivision(s.ISOCode())
+			assert.Nil(t, err, s.ISOCode())
+			assert.Equal(t, code, found.Code)
+		}
+	}
+}
+
+func TestSubdivisionParent(t *testing.T) {
+	c := countries.Get("IT")
+	s := c.Subdivision("RG")
+	assert.Equal(t, "Sicilia", s.Parent().Name)
+	region := s.Parent()
+	assert.Equal(t, "", region.Parent().Name)
+
+	s = countries.Get("ES").Subdivision("B")
+	assert.Equal(t, "Cataluña", s.Parent().Name)
+
+	s = countries.Get("GB").Subdivision("KEN")
+	assert.Equal(t, "England", s.Parent().Name)
+	s = countries.Get("GB").Subdivision("BFS")
+	assert.Equal(t, "Northern Ireland", s.Parent().Name)
+
+	s = countries.Get("FR").Subdivision("67")
+	assert.Equal(t, "Alsace", s.Parent().Name)
+	assert.Equal(t, "Grand Est", s.Parent().Parent().Name)
+
+	s = countries.Get("US").Subdivision("CA")
+	assert.Equal(t, "", s.Parent().Name)
+
+	s = countries.Subdivision{}
+	assert.Equal(t, "", s.Parent().Name)
+}
+
+func TestSubdivisionChildren(t *testing.T) {
+	s := countries.Get("IT").Subdivision("32")
+	children := s.Children()
+	assert.Equal(t, 2, len(children))
+	assert.Equal(t, "BZ", children[0].Code)
+	assert.Equal(t, "TN", children[1].Code)
+
+	s = countries.Get("IT").Subdivision("RG")
+	assert.Equal(t, 0, len(s.Children()))
+
+	s = countries.Subdivision{}
+	assert.Equal(t, 0, len(s.Children()))
+}
+
+func TestTopLevelSubdivisions(t *testing.T) {
+	top := countries.Get("IT").TopLevelSubdivisions()
+	assert.Equal(t, 20, len(top))
+	assert.Equal(t, "21", top[0].Code)
+
+	assert.Equal(t, 4, len(countries.Get("GB").TopLevelSubdivisions()))
+
+	c := countries.Get("US")
+	assert.Equal(t, len(c.Subdivisions), len(c.TopLevelSubdivisions()))
+}
+
+func TestWalkSubdivisions(t *testing.T) {
+	c := countries.Get("ES")
+	var codes []string
+	var depths []int
+	err := c.WalkSubdivisions(func(s countries.Subdivision, depth int) error {
+		codes = append(codes, s.Code)
+		depths = append(depths, depth)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, len(c.Subdivisions), len(codes))
+	assert.Equal(t, []string{"AN", "AL", "CA", "CO", "GR", "H", "J", "MA", "SE", "AR"}, codes[:10])
+	assert.Equal(t, []int{0, 1, 1, 1, 1, 1, 1, 1, 1, 0}, depths[:10])
+
+	stop := errors.New("stop")
+	count := 0
+	err = c.WalkSubdivisions(func(s countries.Subdivision, depth int) error {
+		count++
+		if count == 3 {
+			return stop
+		}
+		return nil
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestSubdivisionTypeLabel(t *testing.T) {
+	assert.Equal(t, "Province", countries.SubdivisionTypeProvince.Label(""))
+	assert.Equal(t, "Province", countries.SubdivisionTypeProvince.Label("en"))
+	assert.Equal(t, "Provincia", countries.SubdivisionTypeProvince.Label("it"))
+	assert.Equal(t, "Kanton", countries.SubdivisionTypeCanton.Label("de_CH"))
+	assert.Equal(t, "Préfecture", countries.SubdivisionTypePrefecture.Label("fr-CA"))
+	assert.Equal(t, "Two-tier county", countries.SubdivisionTypeTwoTierCounty.Label("it"))
+	assert.Equal(t, "London borough", countries.SubdivisionTypeLondonBorough.Label(""))
+	assert.Equal(t, "Autonomous city in North Africa", countries.SubdivisionTypeAutonomousCityInNorthAfrica.Label(""))
+	assert.Equal(t, "", countries.SubdivisionType("").Label("it"))
+	assert.Equal(t, "X", countries.SubdivisionType("_x").Label(""))
+	assert.Equal(t, "A b", countries.SubdivisionType("a__b").Label(""))
+	assert.Equal(t, "État fédéré", countries.SubdivisionType("état_fédéré").Label(""))
+	assert.Equal(t, 108, len(countries.SubdivisionTypes))
+}
+
+func TestSubdivisionsOfType(t *testing.T) {
+	c := countries.Get("IT")
+	regions := c.SubdivisionsOfType(countries.SubdivisionTypeAutonomousRegion)
+	assert.Equal(t, 5, len(regions))
+	assert.Equal(t, "23", regions[0].Code)
+	assert.Equal(t, 0, len(c.SubdivisionsOfType(countries.SubdivisionTypePrefecture)))
+
+	assert.Equal(t, 47, len(countries.Get("JP").SubdivisionsOfType(countries.SubdivisionTypePrefecture)))
+}
+
+func TestCountrySubdivisionTypes(t *testing.T) {
+	assert.Equal(t, []countries.SubdivisionType{
+		countries.SubdivisionTypeAutonomousProvince,
+		countries.SubdivisionTypeAutonomousRegion,
+		countries.SubdivisionTypeDecentralizedRegionalEntity,
+		countries.SubdivisionTypeFreeMunicipalConsortium,
+		countries.SubdivisionTypeMetropolitanCity,
+		countries.SubdivisionTypeProvince,
+		countries.SubdivisionTypeRegion,
+	}, countries.Get("IT").SubdivisionTypes())
+	assert.Equal(t, 0, len(countries.Get("AQ").SubdivisionTypes()))
+}
+
+func TestSubdivisionList(t *testing.T) {
+	c := countries.Get("ES")
+	list := c.SubdivisionList(countries.SubdivisionListOptions{})
+	assert.Equal(t, len(c.Subdivisions), len(list))
+	assert.Equal(t, "A", list[0].Code)
+	assert.Equal(t, "AB", list[1].Code)
+	assert.Equal(t, "ZA", list[len(list)-1].Code)
+
+	list = c.SubdivisionList(countries.SubdivisionListOptions{Order: countries.SubdivisionOrderName})
+	assert.Equal(t, len(c.Subdivisions), len(list))
+	index := make(map[string]int)
+	for i, s := range list {
+		index[s.Name] = i
+	}
+	assert.Equal(t, 0, index["Albacete"])
+	// Ávila is collated as Avila, before Badajoz.
+	assert.Equal(t, index["Badajoz"]-1, index["Ávila"])
+
+	c = countries.Get("IT")
+	list = c.SubdivisionList(countries.SubdivisionListOptions{Order: countries.SubdivisionOrderName, Locale: "en"})
+	names := make([]string, 0, len(list))
+	for _, s := range list {
+		name := s.Translations["en"]
+		if name == "" {
+			name = s.Name
+		}
+		names = append(names, countries.NormalizeName(name))
+	}
+	assert.True(t, sort.StringsAreSorted(names))
+	assert.Equal(t, list, c.SubdivisionList(countries.SubdivisionListOptions{Order: countries.SubdivisionOrderName, Locale: "en"}))
+}
+
+func TestSubdivisionsByName(t *testing.T) {
+	c := countries.Get("ES")
+	list := c.SubdivisionsByName("Navarra")
+	if assert.Equal(t, 2, len(list)) {
+		assert.Equal(t, "NA", list[0].Code)
+		assert.Equal(t, "NC", list[1].Code)
+	}
+	list = c.SubdivisionsByName("Andalucia")
+	if assert.Equal(t, 1, len(list)) {
+		assert.Equal(t, "AN", list[0].Code)
+	}
+	assert.Equal(t, 0, len(c.SubdivisionsByName("xx")))
+	assert.Equal(t, 0, len(c.SubdivisionsByName("Andalusia", "de")))
+}
+
+func ExampleGetSubdivision() {
+	c, s, _ := countries.GetSubdivision("US-CA")
+	fmt.Println(c.Alpha2, s.Name, s.ISOCode())
+	// Output: US California US-CA
+}
+
+func ExampleSubdivision_Parent() {
+	s := countries.Get("IT").Subdivision("RG")
+	fmt.Println(s.Name, s.Parent().Name)
+	// Output: Ragusa Sicilia
+}